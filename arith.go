@@ -19,6 +19,45 @@ func Dot(x, y []Decimal) (sum Decimal) {
 	return sum
 }
 
+// DotFMA is like [Dot], but accumulates each product using [FMA] so that every
+// element is only rounded once:
+//
+//	for i, v := range x {
+//		sum = FMA(y[i], v, sum)
+//	}
+//	return sum
+func DotFMA(x, y []Decimal) (sum Decimal) {
+	for i, v := range x {
+		sum = FMA(y[i], v, sum)
+	}
+
+	return sum
+}
+
+// FMA returns x*y+z, computed with only one rounding using the default
+// rounding mode set by [SetDefaultRoundingMode]. That is, FMA returns the
+// fused multiply-add of x, y and z.
+func FMA(x, y, z Decimal) Decimal {
+	return FMAWithMode(x, y, z, defaultRoundingMode())
+}
+
+// FMAWithMode returns x*y+z, computed with only one rounding using the
+// provided rounding mode.
+func FMAWithMode(x, y, z Decimal, mode RoundingMode) Decimal {
+	res, _ := x.fma(y, z, rounding{RoundingMode: mode})
+	return res
+}
+
+// Remainder returns the IEEE 754 remainder of d divided by o, which is
+// d - n*o where n is the integer nearest to d/o, rounding half to even.
+//
+// Remainder is equivalent to:
+//
+//	d.RemainderNear(o)
+func Remainder(d, o Decimal) Decimal {
+	return d.RemainderNear(o)
+}
+
 // Abs returns the absolute value of the decimal.
 func (d Decimal) Abs() Decimal {
 	if d.IsPositive() {
@@ -27,22 +66,18 @@ func (d Decimal) Abs() Decimal {
 	return FromInt(new(big.Int).Abs(d.Int(nil)))
 }
 
-// Add adds d and o, rounded using the [DefaultRoundingMode], and returns the
-// result.
+// Add adds d and o, rounded using the default rounding mode set by
+// [SetDefaultRoundingMode], and returns the result.
 func (d Decimal) Add(o Decimal) Decimal {
-	return d.AddWithMode(o, DefaultRoundingMode)
+	return d.AddWithMode(o, defaultRoundingMode())
 }
 
 // AddWithMode adds d and o, rounding using the provided rounding mode, and
 // returns the result.
 func (d Decimal) AddWithMode(o Decimal, mode RoundingMode) Decimal {
 	if d.isSpecial() || o.isSpecial() {
-		if d.IsNaN() {
-			return d
-		}
-
-		if o.IsNaN() {
-			return o
+		if d.IsNaN() || o.IsNaN() {
+			return propagateNaN(d, o)
 		}
 
 		if d.isInf() {
@@ -65,25 +100,187 @@ func (d Decimal) AddWithMode(o Decimal, mode RoundingMode) Decimal {
 		return inf(o.Signbit())
 	}
 
-	return d.add(o, mode, false)
+	res, _ := d.add(o, rounding{RoundingMode: mode}, false)
+	return res
 }
 
-// Mul multiplies d and o, rounding using the [DefaultRoundingMode], and
-// returns the result.
+func (d Decimal) fma(o, a Decimal, mode rounding) (Decimal, bool) {
+	if d.isSpecial() || o.isSpecial() || a.isSpecial() {
+		if d.IsNaN() || o.IsNaN() || a.IsNaN() {
+			return propagateNaN(d, o, a), false
+		}
+
+		if d.isInf() || o.isInf() {
+			if d.IsZero() || o.IsZero() {
+				return nan(payloadOpFMA, d.payloadVal(), o.payloadVal()), false
+			}
+
+			prd := inf(d.Signbit() != o.Signbit())
+
+			if a.isInf() && a.Signbit() != prd.Signbit() {
+				return nan(payloadOpFMA, prd.payloadVal(), a.payloadVal()), false
+			}
+
+			return prd, false
+		}
+
+		return a, false
+	}
+
+	dSig, dExp := d.decompose()
+	oSig, oExp := o.decompose()
+	aSig, aExp := a.decompose()
+
+	pNeg := d.Signbit() != o.Signbit()
+	aNeg := a.Signbit()
+
+	prd := dSig.mul(oSig)
+	pExp := int(dExp) + int(oExp) - exponentBias
+
+	if prd == (uint256{}) {
+		if aSig == (uint128{}) {
+			if pNeg == aNeg {
+				return zero(aNeg), false
+			}
+
+			return zero(mode.RoundingMode == ToNegativeInf), false
+		}
+
+		return a, false
+	}
+
+	if aSig == (uint128{}) {
+		sig, exp, inexact := mode.reduce256(pNeg, prd, int16(pExp), 0, 0)
+
+		if exp > maxBiasedExponent {
+			return inf(pNeg), true
+		}
+
+		return compose(pNeg, sig, exp), inexact
+	}
+
+	sum := uint256{aSig[0], aSig[1], 0, 0}
+	exp := int(aExp)
+	trunc := int8(0)
+	truncSum := false
+
+	if pExp > exp {
+		for pExp-exp >= 19 && prd[3] == 0 && prd[2] == 0 {
+			prd = prd.mul64(10_000_000_000_000_000_000)
+			pExp -= 19
+		}
+
+		for pExp > exp && prd[3] <= 0x0ccc_cccc_cccc_cccc {
+			prd = prd.mul64(10)
+			pExp--
+		}
+
+		for exp < pExp {
+			var rem uint64
+			sum, rem = sum.div10()
+			if rem != 0 {
+				trunc = 1
+			}
+
+			if sum == (uint256{}) {
+				exp = pExp
+				break
+			}
+
+			exp++
+		}
+
+		truncSum = true
+	} else if exp > pExp {
+		for exp-pExp >= 19 && sum[3] == 0 && sum[2] == 0 {
+			sum = sum.mul64(10_000_000_000_000_000_000)
+			exp -= 19
+		}
+
+		for exp > pExp && sum[3] <= 0x0ccc_cccc_cccc_cccc {
+			sum = sum.mul64(10)
+			exp--
+		}
+
+		for pExp < exp {
+			var rem uint64
+			prd, rem = prd.div10()
+			if rem != 0 {
+				trunc = 1
+			}
+
+			if prd == (uint256{}) {
+				pExp = exp
+				break
+			}
+
+			pExp++
+		}
+	}
+
+	neg := pNeg
+
+	var sig256 uint256
+	if pNeg == aNeg {
+		sig256 = prd.add(sum)
+	} else {
+		var brw uint
+		sig256, brw = prd.sub(sum)
+
+		if truncSum {
+			trunc *= -1
+		}
+
+		if brw != 0 {
+			sig256, _ = uint256{}.sub(sig256)
+			neg = aNeg
+			trunc *= -1
+		} else if sig256 == (uint256{}) && trunc == 0 {
+			return zero(mode.RoundingMode == ToNegativeInf), false
+		}
+	}
+
+	sig, rexp, inexact := mode.reduce256(neg, sig256, int16(exp), trunc, 0)
+
+	if rexp > maxBiasedExponent {
+		return inf(neg), true
+	}
+
+	return compose(neg, sig, rexp), inexact
+}
+
+// Mod returns the remainder of d divided by o using floored division, as
+// returned by [Decimal.QuoRemFloor]. The result has the same sign as o.
+func (d Decimal) Mod(o Decimal) Decimal {
+	_, rem := d.QuoRemFloor(o)
+	return rem
+}
+
+// ModEuclid returns the remainder of d divided by o using Euclidean
+// division, as returned by [Decimal.QuoRemEuclid]. The result is never
+// negative.
+func (d Decimal) ModEuclid(o Decimal) Decimal {
+	_, rem := d.QuoRemEuclid(o)
+	return rem
+}
+
+// Mul multiplies d and o, rounding using the default rounding mode set by
+// [SetDefaultRoundingMode], and returns the result.
 func (d Decimal) Mul(o Decimal) Decimal {
-	return d.MulWithMode(o, DefaultRoundingMode)
+	return d.MulWithMode(o, defaultRoundingMode())
 }
 
 // MulWithMode multiplies d and o, rounding using the provided rounding mode,
 // and returns the result.
 func (d Decimal) MulWithMode(o Decimal, mode RoundingMode) Decimal {
-	if d.isSpecial() || o.isSpecial() {
-		if d.IsNaN() {
-			return d
-		}
+	res, _ := d.mul(o, rounding{RoundingMode: mode})
+	return res
+}
 
-		if o.IsNaN() {
-			return o
+func (d Decimal) mul(o Decimal, mode rounding) (Decimal, bool) {
+	if d.isSpecial() || o.isSpecial() {
+		if d.IsNaN() || o.IsNaN() {
+			return propagateNaN(d, o), false
 		}
 
 		if !d.isSpecial() {
@@ -99,7 +296,7 @@ func (d Decimal) MulWithMode(o Decimal, mode RoundingMode) Decimal {
 					rhs = payloadValNegInfinite
 				}
 
-				return nan(payloadOpMul, lhs, rhs)
+				return nan(payloadOpMul, lhs, rhs), false
 			}
 		} else if !o.isSpecial() {
 			sig, _ := o.decompose()
@@ -114,11 +311,11 @@ func (d Decimal) MulWithMode(o Decimal, mode RoundingMode) Decimal {
 					rhs = payloadValNegZero
 				}
 
-				return nan(payloadOpMul, lhs, rhs)
+				return nan(payloadOpMul, lhs, rhs), false
 			}
 		}
 
-		return inf(d.Signbit() != o.Signbit())
+		return inf(d.Signbit() != o.Signbit()), false
 	}
 
 	dSig, dExp := d.decompose()
@@ -128,64 +325,94 @@ func (d Decimal) MulWithMode(o Decimal, mode RoundingMode) Decimal {
 	neg := d.Signbit() != o.Signbit()
 
 	var sig uint128
+	var inexact bool
 	if dSig[1] == 0 && oSig[1] == 0 {
 		sig1, sig0 := bits.Mul64(dSig[0], oSig[0])
 
 		if sig1 == 0 && sig0 == 0 {
-			return zero(neg)
+			return zero(neg), false
 		}
 
-		sig, exp = mode.reduce128(neg, uint128{sig0, sig1}, exp, 0)
+		sig, exp, inexact = mode.reduce128(neg, uint128{sig0, sig1}, exp, 0, 0)
 	} else {
 		sig256 := dSig.mul(oSig)
 
 		if sig256 == (uint256{}) {
-			return zero(neg)
+			return zero(neg), false
 		}
 
-		sig, exp = mode.reduce256(neg, sig256, exp, 0)
+		sig, exp, inexact = mode.reduce256(neg, sig256, exp, 0, 0)
 	}
 
 	if exp > maxBiasedExponent {
-		return inf(neg)
+		return inf(neg), true
 	}
 
-	return compose(neg, sig, exp)
+	return compose(neg, sig, exp), inexact
 }
 
-// Pow raises d to the power of o, rounding using the [DefaultRoundingMode],
-// and returns the result.
+// PowInt raises d to the integer power n, rounding using the default rounding
+// mode set by [SetDefaultRoundingMode], and returns the result. A result that
+// can be represented exactly is, with the exponent closest to that of d
+// multiplied by n.
+func PowInt(d Decimal, n int64) Decimal {
+	res, _ := d.pow(FromInt64(n), DefaultRounder().rounding())
+	return res
+}
+
+// PowIntWithMode raises d to the integer power n, rounding using the provided
+// rounding mode, and returns the result.
+func PowIntWithMode(d Decimal, n int64, mode RoundingMode) Decimal {
+	res, _ := d.pow(FromInt64(n), rounding{RoundingMode: mode})
+	return res
+}
+
+// Pow raises d to the power of o, rounding using the default rounding mode set
+// by [SetDefaultRoundingMode], and returns the result.
 func (d Decimal) Pow(o Decimal) Decimal {
-	return d.PowWithMode(o, DefaultRoundingMode)
+	return d.PowWithMode(o, defaultRoundingMode())
 }
 
 // PowWithMode raises d to the power of o, rounding using the provided rounding
 // mode, and returns the result.
 func (d Decimal) PowWithMode(o Decimal, mode RoundingMode) Decimal {
+	res, _ := d.pow(o, rounding{RoundingMode: mode})
+	return res
+}
+
+func (d Decimal) pow(o Decimal, mode rounding) (Decimal, bool) {
+	if d.isSignaling() || o.isSignaling() {
+		return propagateNaN(d, o), false
+	}
+
 	if o.IsZero() {
-		return one(false)
+		return one(false), false
+	}
+
+	if d.IsFinite() && !d.IsZero() && o.IsInteger() {
+		if n, ok := o.Int64(); ok {
+			if res, inexact, ok := d.powExact(n, mode); ok {
+				return res, inexact
+			}
+		}
 	}
 
 	if d.isOne() {
 		if !d.Signbit() || o.isInf() {
-			return one(false)
+			return one(false), false
 		}
 	}
 
 	if o.isOne() {
 		if o.Signbit() {
-			return one(false).QuoWithMode(d, mode)
+			return one(false).quo(d, mode)
 		}
 
-		return d
-	}
-
-	if d.IsNaN() {
-		return d
+		return d, false
 	}
 
-	if o.IsNaN() {
-		return o
+	if d.IsNaN() || o.IsNaN() {
+		return propagateNaN(d, o), false
 	}
 
 	dNeg := d.Signbit()
@@ -194,18 +421,18 @@ func (d Decimal) PowWithMode(o Decimal, mode RoundingMode) Decimal {
 	if o.isInf() {
 		if d.IsZero() {
 			if oNeg {
-				return inf(false)
+				return inf(false), false
 			}
 
-			return zero(false)
+			return zero(false), false
 		}
 
 		if d.isInf() {
 			if oNeg {
-				return zero(false)
+				return zero(false), false
 			}
 
-			return inf(false)
+			return inf(false), false
 		}
 
 		dSig, dExp := d.decompose()
@@ -215,18 +442,18 @@ func (d Decimal) PowWithMode(o Decimal, mode RoundingMode) Decimal {
 			l10 := int16(dSig.log10())
 			if l10 > -dExp {
 				if oNeg {
-					return zero(false)
+					return zero(false), false
 				}
 
-				return inf(false)
+				return inf(false), false
 			}
 		}
 
 		if oNeg {
-			return zero(false)
+			return zero(false), false
 		}
 
-		return inf(false)
+		return inf(false), false
 	}
 
 	oSig, oExp := o.decompose()
@@ -252,10 +479,10 @@ func (d Decimal) PowWithMode(o Decimal, mode RoundingMode) Decimal {
 		}
 
 		if oNeg {
-			return inf(neg)
+			return inf(neg), false
 		}
 
-		return zero(neg)
+		return zero(neg), false
 	}
 
 	if d.isInf() {
@@ -270,17 +497,17 @@ func (d Decimal) PowWithMode(o Decimal, mode RoundingMode) Decimal {
 			}
 
 			if oNeg {
-				return zero(neg)
+				return zero(neg), false
 			}
 
-			return inf(neg)
+			return inf(neg), false
 		}
 
 		if oNeg {
-			return zero(false)
+			return zero(false), false
 		}
 
-		return inf(false)
+		return inf(false), false
 	}
 
 	dSig, dExp := d.decompose()
@@ -304,7 +531,7 @@ func (d Decimal) PowWithMode(o Decimal, mode RoundingMode) Decimal {
 				rhs = payloadValNegFinite
 			}
 
-			return nan(payloadOpPow, payloadValNegFinite, rhs)
+			return nan(payloadOpPow, payloadValNegFinite, rhs), false
 		}
 
 		if oExp == exponentBias {
@@ -318,14 +545,14 @@ func (d Decimal) PowWithMode(o Decimal, mode RoundingMode) Decimal {
 	if !oNeg && oExp >= exponentBias && dSig == (uint128{1, 0}) {
 		if oSig[1] != 0 || oSig[0] > maxUnbiasedExponent {
 			if dExp == exponentBias {
-				return one(neg)
+				return one(neg), false
 			}
 
 			if dExp < exponentBias {
-				return zero(neg)
+				return zero(neg), true
 			}
 
-			return inf(neg)
+			return inf(neg), true
 		}
 
 		var p10 int64
@@ -348,33 +575,33 @@ func (d Decimal) PowWithMode(o Decimal, mode RoundingMode) Decimal {
 			p10 = 10_000_000
 		default:
 			if dExp == exponentBias {
-				return one(neg)
+				return one(neg), false
 			}
 
 			if dExp < exponentBias {
-				return zero(neg)
+				return zero(neg), true
 			}
 
-			return inf(neg)
+			return inf(neg), true
 		}
 
 		exp64 := int64(dExp-exponentBias)*p10*int64(oSig[0]) + exponentBias
 
 		if exp64 < minBiasedExponent-maxDigits {
-			return zero(neg)
+			return zero(neg), true
 		}
 
 		if exp64 > maxBiasedExponent+maxDigits {
-			return inf(neg)
+			return inf(neg), true
 		}
 
-		sig, exp := mode.reduce128(dNeg, dSig, int16(exp64), 0)
+		sig, exp, inexact := mode.reduce128(dNeg, dSig, int16(exp64), 0, 0)
 
 		if exp > maxBiasedExponent {
-			return inf(neg)
+			return inf(neg), true
 		}
 
-		return compose(neg, sig, exp)
+		return compose(neg, sig, exp), inexact
 	}
 
 	if dExp&1 == 0 && oExp == exponentBias-1 && dSig == (uint128{1, 0}) && oSig == (uint128{5, 0}) {
@@ -384,7 +611,7 @@ func (d Decimal) PowWithMode(o Decimal, mode RoundingMode) Decimal {
 			exp *= -1
 		}
 
-		return compose(neg, dSig, exp+exponentBias)
+		return compose(neg, dSig, exp+exponentBias), false
 	}
 
 	inv, res, trunc := decomposed192{
@@ -393,15 +620,15 @@ func (d Decimal) PowWithMode(o Decimal, mode RoundingMode) Decimal {
 	}.log()
 
 	if res.sig == (uint192{}) {
-		return one(neg)
+		return one(neg), false
 	}
 
 	if int64(res.exp)+int64(oExp) > maxBiasedExponent+maxDigits {
 		if oNeg != inv {
-			return zero(neg)
+			return zero(neg), true
 		}
 
-		return inf(neg)
+		return inf(neg), true
 	}
 
 	res, trunc = res.mul(decomposed192{
@@ -410,63 +637,179 @@ func (d Decimal) PowWithMode(o Decimal, mode RoundingMode) Decimal {
 	}, trunc)
 
 	if res.sig == (uint192{}) {
-		return one(neg)
+		return one(neg), false
 	}
 
 	l10 := res.sig.log10()
 
 	if int(res.exp) > 5-l10 {
 		if oNeg != inv {
-			return zero(neg)
+			return zero(neg), true
 		}
 
-		return inf(neg)
+		return inf(neg), true
 	}
 
 	if res.sig == (uint192{}) {
-		return one(neg)
+		return one(neg), false
 	}
 
 	res, trunc = res.epow(int16(l10), trunc)
 
 	if res.exp > maxUnbiasedExponent+58 {
 		if oNeg != inv {
-			return zero(neg)
+			return zero(neg), true
 		}
 
-		return inf(neg)
+		return inf(neg), true
 	}
 
 	if oNeg != inv {
-		res, trunc = res.rcp(trunc)
-		trunc *= -1
+		res, _ = res.rcp(trunc)
+	}
+
+	// The error of the logarithm of d is multiplied by o, adding to the error
+	// of the exponential.
+	digits := expDigits(res)
+	if l10 := 54 - (oSig.log10() + 1 + int(oExp) - exponentBias); l10 < digits {
+		digits = l10
 	}
 
-	sig, exp := mode.reduce192(neg, res.sig, res.exp+exponentBias, trunc)
+	sig, exp, ok := mode.reduceApprox(neg, res, digits)
+	inexact := true
+
+	if !ok {
+		sig, exp, inexact = d.powBig(o, neg, mode)
+	}
 
 	if exp > maxBiasedExponent {
-		return inf(neg)
+		return inf(neg), true
 	}
 
-	return compose(neg, sig, exp)
+	return compose(neg, sig, exp), inexact
 }
 
-// Quo divides d by o, rounding using the [DefaultRoundingMode], and returns
-// the result.
+// powExact returns d**n, where d is finite and not zero, when the power of
+// its coefficient fits in 256 bits, so that it is rounded once from the exact
+// result. An exact result has the exponent closest to the preferred exponent,
+// the exponent of d multiplied by n. A negative power is exact only when the
+// coefficient of d has no prime factors other than two and five.
+func (d Decimal) powExact(n int64, mode rounding) (Decimal, bool, bool) {
+	neg := d.Signbit() && n&1 != 0
+	sig, exp := d.decompose()
+	e := int64(exp) - exponentBias
+	pref := e
+
+	for {
+		quo, rem := sig.div10()
+		if rem != 0 {
+			break
+		}
+
+		sig = quo
+		e++
+	}
+
+	m := uint64(n)
+	if n < 0 {
+		m = -m
+	}
+
+	if (e != 0 || pref != 0) && m > 1<<20 {
+		return Decimal{}, false, false
+	}
+
+	pref *= n
+
+	if n < 0 {
+		// 1/(2**twos * 5**fives) is 2**(k-twos) * 5**(k-fives) * 10**-k,
+		// where k is the larger of twos and fives.
+		twos := bits.TrailingZeros64(sig[0])
+		if sig[0] == 0 {
+			twos = 64 + bits.TrailingZeros64(sig[1])
+		}
+
+		sig = sig.rsh(uint(twos))
+
+		fives := 0
+		for {
+			quo, rem := sig.div(uint128{5, 0})
+			if rem != (uint128{}) {
+				break
+			}
+
+			sig = quo
+			fives++
+		}
+
+		if sig != (uint128{1, 0}) {
+			return Decimal{}, false, false
+		}
+
+		k := max(twos, fives)
+
+		fact, ok := uint128{5, 0}.pow(uint64(k - fives))
+		if !ok || fact[3] != 0 || fact[2] != 0 {
+			return Decimal{}, false, false
+		}
+
+		sig = uint128{fact[0], fact[1]}.lsh(uint(k - twos))
+		e = -e - int64(k)
+	}
+
+	pow, ok := sig.pow(m)
+	if !ok {
+		return Decimal{}, false, false
+	}
+
+	e = e*int64(m) + exponentBias
+
+	if e > maxBiasedExponent+maxDigits {
+		return inf(neg), true, true
+	}
+
+	if e < minBiasedExponent-2*maxDigits-10 {
+		return zero(neg), true, true
+	}
+
+	resSig, resExp, inexact := mode.reduce256(neg, pow, int16(e), 0, 0)
+
+	if resExp > maxBiasedExponent {
+		return inf(neg), true, true
+	}
+
+	if !inexact {
+		for int64(resExp)-exponentBias > pref && resExp > minBiasedExponent {
+			tmp := resSig.mul64(10)
+			if tmp[1] > 0x0002_7fff_ffff_ffff {
+				break
+			}
+
+			resSig = tmp
+			resExp--
+		}
+	}
+
+	return compose(neg, resSig, resExp), inexact, true
+}
+
+// Quo divides d by o, rounding using the default rounding mode set by
+// [SetDefaultRoundingMode], and returns the result.
 func (d Decimal) Quo(o Decimal) Decimal {
-	return d.QuoWithMode(o, DefaultRoundingMode)
+	return d.QuoWithMode(o, defaultRoundingMode())
 }
 
 // QuoWithMode divides d by o, rounding using the provided rounding mode, and
 // returns the result.
 func (d Decimal) QuoWithMode(o Decimal, mode RoundingMode) Decimal {
-	if d.isSpecial() || o.isSpecial() {
-		if d.IsNaN() {
-			return d
-		}
+	res, _ := d.quo(o, rounding{RoundingMode: mode})
+	return res
+}
 
-		if o.IsNaN() {
-			return o
+func (d Decimal) quo(o Decimal, mode rounding) (Decimal, bool) {
+	if d.isSpecial() || o.isSpecial() {
+		if d.IsNaN() || o.IsNaN() {
+			return propagateNaN(d, o), false
 		}
 
 		if d.isInf() {
@@ -481,14 +824,14 @@ func (d Decimal) QuoWithMode(o Decimal, mode RoundingMode) Decimal {
 					rhs = payloadValNegInfinite
 				}
 
-				return nan(payloadOpQuo, lhs, rhs)
+				return nan(payloadOpQuo, lhs, rhs), false
 			}
 
-			return inf(d.Signbit() != o.Signbit())
+			return inf(d.Signbit() != o.Signbit()), false
 		}
 
 		if o.isInf() {
-			return zero(d.Signbit() != o.Signbit())
+			return zero(d.Signbit() != o.Signbit()), false
 		}
 	}
 
@@ -507,14 +850,14 @@ func (d Decimal) QuoWithMode(o Decimal, mode RoundingMode) Decimal {
 				rhs = payloadValNegZero
 			}
 
-			return nan(payloadOpQuo, lhs, rhs)
+			return nan(payloadOpQuo, lhs, rhs), false
 		}
 
-		return inf(d.Signbit() != o.Signbit())
+		return inf(d.Signbit() != o.Signbit()), false
 	}
 
 	if dSig == (uint128{}) {
-		return zero(d.Signbit() != o.Signbit())
+		return zero(d.Signbit() != o.Signbit()), false
 	}
 
 	exp := (dExp - exponentBias) - (oExp - exponentBias) + exponentBias
@@ -586,6 +929,10 @@ func (d Decimal) QuoWithMode(o Decimal, mode RoundingMode) Decimal {
 
 	trunc := int8(0)
 
+	// drop holds the digits dropped from the quotient, which are less than
+	// pow, so that they can be taken into account for stochastic rounding.
+	drop, pow := uint64(0), uint64(1)
+
 	for rem != (uint128{}) && sig[1] <= 0x0002_7fff_ffff_ffff {
 		for rem[1] <= 0x0002_7fff_ffff_ffff && sig[1] <= 0x0002_7fff_ffff_ffff {
 			rem = rem.mul64(10_000)
@@ -611,6 +958,9 @@ func (d Decimal) QuoWithMode(o Decimal, mode RoundingMode) Decimal {
 			if rem192 != 0 {
 				trunc = 1
 			}
+
+			drop += rem192 * pow
+			pow *= 10
 		}
 
 		sig = uint128{sig192[0], sig192[1]}
@@ -620,32 +970,97 @@ func (d Decimal) QuoWithMode(o Decimal, mode RoundingMode) Decimal {
 		trunc = 1
 	}
 
+	frac := mode.shiftFrac(mode.quoFrac(rem, oSig), 0, drop, pow)
+
 	neg := d.Signbit() != o.Signbit()
-	sig, exp = mode.reduce128(neg, sig, exp, trunc)
+	sig, exp, inexact := mode.reduce128(neg, sig, exp, trunc, frac)
 
 	if exp > maxBiasedExponent {
-		return inf(neg)
+		return inf(neg), true
 	}
 
-	return compose(neg, sig, exp)
+	return compose(neg, sig, exp), inexact
 }
 
-// QuoRem divides d by o, rounding using the [DefaultRoundingMode], and returns
-// the result as an integer quotient and a remainder.
+// QuoRem divides d by o, rounding using the default rounding mode set by
+// [SetDefaultRoundingMode], and returns the result as an integer quotient and
+// a remainder.
 func (d Decimal) QuoRem(o Decimal) (Decimal, Decimal) {
-	return d.QuoRemWithMode(o, DefaultRoundingMode)
+	return d.QuoRemWithMode(o, defaultRoundingMode())
 }
 
 // QuoRem divides d by o, rounding using the provided rounding mode, and
 // returns the result as an integer quotient and a remainder.
 func (d Decimal) QuoRemWithMode(o Decimal, mode RoundingMode) (Decimal, Decimal) {
-	if d.isSpecial() || o.isSpecial() {
-		if d.IsNaN() {
-			return d, d
+	quo, rem, _ := d.quoRem(o, rounding{RoundingMode: mode})
+	return quo, rem
+}
+
+// QuoRemEuclid divides d by o and returns the result as an integer quotient
+// and a remainder, using Euclidean division. The remainder is always
+// non-negative, and the quotient is chosen such that d = quo*o + rem. If the
+// remainder has too many digits to be represented, which can happen when d is
+// much smaller in magnitude than o, it is rounded towards zero so that it
+// remains less than |o|.
+//
+// Special values are handled in the same way as [Decimal.QuoRemWithMode].
+func (d Decimal) QuoRemEuclid(o Decimal) (Decimal, Decimal) {
+	quo, rem, _ := d.quoRem(o, rounding{RoundingMode: ToZero})
+
+	if quo.IsNaN() || rem.IsNaN() {
+		return quo, rem
+	}
+
+	if rem.IsZero() {
+		return quo, zero(false)
+	}
+
+	if !rem.Signbit() {
+		return quo, rem
+	}
+
+	if o.Signbit() {
+		return quo.AddWithMode(one(false), ToZero), rem.SubWithMode(o, ToZero)
+	}
+
+	return quo.SubWithMode(one(false), ToZero), rem.AddWithMode(o, ToZero)
+}
+
+// QuoRemFloor divides d by o and returns the result as an integer quotient
+// and a remainder, rounding the quotient towards negative infinity. The
+// remainder has the same sign as o, matching the behaviour of Python's divmod.
+// If the remainder has too many digits to be represented, which can happen
+// when d is much smaller in magnitude than o, it is rounded towards zero so
+// that its magnitude remains less than |o|.
+//
+// Special values are handled in the same way as [Decimal.QuoRemWithMode].
+func (d Decimal) QuoRemFloor(o Decimal) (Decimal, Decimal) {
+	quo, rem, _ := d.quoRem(o, rounding{RoundingMode: ToZero})
+
+	if quo.IsNaN() || rem.IsNaN() {
+		return quo, rem
+	}
+
+	if rem.IsZero() {
+		if rem.Signbit() != o.Signbit() {
+			rem = rem.Neg()
 		}
 
-		if o.IsNaN() {
-			return o, o
+		return quo, rem
+	}
+
+	if rem.Signbit() == o.Signbit() {
+		return quo, rem
+	}
+
+	return quo.SubWithMode(one(false), ToZero), rem.AddWithMode(o, ToZero)
+}
+
+func (d Decimal) quoRem(o Decimal, mode rounding) (Decimal, Decimal, bool) {
+	if d.isSpecial() || o.isSpecial() {
+		if d.IsNaN() || o.IsNaN() {
+			res := propagateNaN(d, o)
+			return res, res, false
 		}
 
 		if d.isInf() {
@@ -661,7 +1076,7 @@ func (d Decimal) QuoRemWithMode(o Decimal, mode RoundingMode) (Decimal, Decimal)
 				}
 
 				res := nan(payloadOpQuoRem, lhs, rhs)
-				return res, res
+				return res, res, false
 			}
 
 			rhs := payloadValPosFinite
@@ -675,11 +1090,11 @@ func (d Decimal) QuoRemWithMode(o Decimal, mode RoundingMode) (Decimal, Decimal)
 				rhs = payloadValNegFinite
 			}
 
-			return inf(d.Signbit() != o.Signbit()), nan(payloadOpQuoRem, lhs, rhs)
+			return inf(d.Signbit() != o.Signbit()), nan(payloadOpQuoRem, lhs, rhs), false
 		}
 
 		if o.isInf() {
-			return zero(d.Signbit() != o.Signbit()), d
+			return zero(d.Signbit() != o.Signbit()), d, false
 		}
 	}
 
@@ -699,7 +1114,7 @@ func (d Decimal) QuoRemWithMode(o Decimal, mode RoundingMode) (Decimal, Decimal)
 			}
 
 			res := nan(payloadOpQuoRem, lhs, rhs)
-			return res, res
+			return res, res, false
 		}
 
 		lhs := payloadValPosFinite
@@ -707,11 +1122,11 @@ func (d Decimal) QuoRemWithMode(o Decimal, mode RoundingMode) (Decimal, Decimal)
 			lhs = payloadValNegFinite
 		}
 
-		return inf(d.Signbit() != o.Signbit()), nan(payloadOpQuoRem, lhs, rhs)
+		return inf(d.Signbit() != o.Signbit()), nan(payloadOpQuoRem, lhs, rhs), false
 	}
 
 	if dSig == (uint128{}) {
-		return zero(d.Signbit() != o.Signbit()), zero(d.Signbit())
+		return zero(d.Signbit() != o.Signbit()), zero(d.Signbit()), false
 	}
 
 	exp := (dExp - exponentBias) - (oExp - exponentBias)
@@ -733,7 +1148,7 @@ func (d Decimal) QuoRemWithMode(o Decimal, mode RoundingMode) (Decimal, Decimal)
 		}
 
 		if exp < 0 || oSig.cmp(dSig) > 0 {
-			return zero(d.Signbit() != o.Signbit()), d
+			return zero(d.Signbit() != o.Signbit()), d, false
 		}
 	} else if exp > 0 {
 		if exp >= 19 && dSig[1] == 0 {
@@ -858,40 +1273,110 @@ func (d Decimal) QuoRemWithMode(o Decimal, mode RoundingMode) (Decimal, Decimal)
 	}
 
 	qneg := d.Signbit() != o.Signbit()
-	qsig, qexp := mode.reduce128(qneg, sig, qexp, trunc)
+	qsig, qexp, inexact := mode.reduce128(qneg, sig, qexp, trunc, 0)
 
 	rneg := d.Signbit()
-	rsig, rexp := mode.reduce128(rneg, rem, rexp, 0)
+	rsig, rexp, _ := mode.reduce128(rneg, rem, rexp, 0, 0)
 
 	quo := compose(qneg, qsig, qexp)
 
 	if qexp > maxBiasedExponent {
 		quo = inf(qneg)
+		inexact = true
 	}
 
 	if rexp > maxBiasedExponent {
-		return quo, inf(rneg)
+		return quo, inf(rneg), inexact
 	}
 
-	return quo, compose(rneg, rsig, rexp)
+	return quo, compose(rneg, rsig, rexp), inexact
 }
 
-// Sub subtracts o from d, rounding using the [DefaultRoundingMode], and
-// returns the result.
+// RemainderNear returns the remainder of d divided by o, where the quotient is
+// rounded to the nearest integer, rounding half to even. This is the IEEE 754
+// remainder operation. Unlike the truncated remainder returned by
+// [Decimal.QuoRem], the result is always exact and its magnitude is at most
+// half that of o, so it may have the opposite sign to d.
+//
+// If d is infinite or o is zero the result is NaN. If o is infinite and d is
+// finite the result is d.
+func (d Decimal) RemainderNear(o Decimal) Decimal {
+	if d.isSpecial() || o.isSpecial() {
+		if d.IsNaN() || o.IsNaN() {
+			return propagateNaN(d, o)
+		}
+
+		if d.isInf() {
+			return nan(payloadOpRemainder, d.payloadVal(), o.payloadVal())
+		}
+
+		return d
+	}
+
+	dSig, dExp := d.decompose()
+	oSig, oExp := o.decompose()
+
+	if oSig == (uint128{}) {
+		return nan(payloadOpRemainder, d.payloadVal(), o.payloadVal())
+	}
+
+	if dSig == (uint128{}) {
+		return d
+	}
+
+	for oExp > dExp && oSig[1] <= 0x0ccc_cccc_cccc_cccc {
+		oSig = oSig.mul64(10)
+		oExp--
+	}
+
+	if oExp > dExp {
+		return d
+	}
+
+	// The remainder is computed modulo 2*o, so the parity of the truncated
+	// quotient is known when breaking ties.
+	mod := uint192{oSig[0], oSig[1], 0}.lsh(1)
+	_, rem := uint192{dSig[0], dSig[1], 0}.div(mod)
+
+	for dExp-oExp >= 19 {
+		_, rem = rem.mul64(10_000_000_000_000_000_000).div(mod)
+		dExp -= 19
+	}
+
+	for dExp > oExp {
+		_, rem = rem.mul64(10).div(mod)
+		dExp--
+	}
+
+	sig := uint128{rem[0], rem[1]}
+	odd := sig.cmp(oSig) >= 0
+	if odd {
+		sig, _ = sig.sub(oSig)
+	}
+
+	neg := d.Signbit()
+	half := uint192{sig[0], sig[1], 0}.lsh(1).cmp(uint192{oSig[0], oSig[1], 0})
+
+	if half > 0 || half == 0 && odd {
+		sig, _ = oSig.sub(sig)
+		neg = !neg
+	}
+
+	return compose(neg, sig, oExp)
+}
+
+// Sub subtracts o from d, rounding using the default rounding mode set by
+// [SetDefaultRoundingMode], and returns the result.
 func (d Decimal) Sub(o Decimal) Decimal {
-	return d.SubWithMode(o, DefaultRoundingMode)
+	return d.SubWithMode(o, defaultRoundingMode())
 }
 
 // SubWithMode subtracts o from d, rounding using the provided rounding mode,
 // and returns the result.
 func (d Decimal) SubWithMode(o Decimal, mode RoundingMode) Decimal {
 	if d.isSpecial() || o.isSpecial() {
-		if d.IsNaN() {
-			return d
-		}
-
-		if o.IsNaN() {
-			return o
+		if d.IsNaN() || o.IsNaN() {
+			return propagateNaN(d, o)
 		}
 
 		if d.isInf() {
@@ -914,35 +1399,37 @@ func (d Decimal) SubWithMode(o Decimal, mode RoundingMode) Decimal {
 		return inf(!o.Signbit())
 	}
 
-	return d.add(o, mode, true)
+	res, _ := d.add(o, rounding{RoundingMode: mode}, true)
+	return res
 }
 
-func (d Decimal) add(o Decimal, mode RoundingMode, subtract bool) Decimal {
+func (d Decimal) add(o Decimal, mode rounding, subtract bool) (Decimal, bool) {
 	dSig, dExp := d.decompose()
 	oSig, oExp := o.decompose()
 
 	if dSig == (uint128{}) {
 		if oSig == (uint128{}) {
 			if subtract {
-				return zero(d.Signbit() && !o.Signbit())
+				return zero(d.Signbit() && !o.Signbit()), false
 			} else {
-				return zero(d.Signbit() && o.Signbit())
+				return zero(d.Signbit() && o.Signbit()), false
 			}
 		}
 
 		if subtract {
-			return compose(!o.Signbit(), oSig, oExp)
+			return compose(!o.Signbit(), oSig, oExp), false
 		}
 
-		return o
+		return o, false
 	}
 
 	if oSig == (uint128{}) {
-		return d
+		return d, false
 	}
 
 	exp := dExp - oExp
 	trunc := int8(0)
+	frac := uint64(0)
 
 	if exp < 0 {
 		if exp <= -19 && oSig[1] == 0 {
@@ -963,6 +1450,8 @@ func (d Decimal) add(o Decimal, mode RoundingMode, subtract bool) Decimal {
 			exp++
 		}
 
+		frac = mode.digitsFrac(dSig, -int(exp))
+
 		if exp < -maxDigits {
 			if dSig != (uint128{}) {
 				dSig = uint128{}
@@ -1072,6 +1561,8 @@ func (d Decimal) add(o Decimal, mode RoundingMode, subtract bool) Decimal {
 			exp--
 		}
 
+		frac = mode.digitsFrac(oSig, int(exp))
+
 		if exp > maxDigits {
 			if oSig != (uint128{}) {
 				oSig = uint128{}
@@ -1162,18 +1653,19 @@ func (d Decimal) add(o Decimal, mode RoundingMode, subtract bool) Decimal {
 	neg := dNeg
 
 	var sig uint128
+	var inexact bool
 	if dNeg == oNeg {
 		sig192 := dSig.add(oSig)
 
 		if sig192 == (uint192{}) {
-			return zero(mode == ToNegativeInf)
+			return zero(mode.RoundingMode == ToNegativeInf), false
 		}
 
 		if trunc == -1 {
 			trunc = 1
 		}
 
-		sig, exp = mode.reduce192(neg, sig192, dExp, trunc)
+		sig, exp, inexact = mode.reduce192(neg, sig192, dExp, trunc, frac)
 	} else {
 		var brw uint
 		sig, brw = dSig.sub(oSig)
@@ -1183,15 +1675,15 @@ func (d Decimal) add(o Decimal, mode RoundingMode, subtract bool) Decimal {
 			neg = !neg
 			trunc *= -1
 		} else if sig == (uint128{}) {
-			return zero(mode == ToNegativeInf)
+			return zero(mode.RoundingMode == ToNegativeInf), false
 		}
 
-		sig, exp = mode.reduce128(neg, sig, dExp, trunc)
+		sig, exp, inexact = mode.reduce128(neg, sig, dExp, trunc, frac)
 	}
 
 	if exp > maxBiasedExponent {
-		return inf(neg)
+		return inf(neg), true
 	}
 
-	return compose(neg, sig, exp)
+	return compose(neg, sig, exp), inexact
 }
@@ -26,6 +26,43 @@ func TestDecimal_Abs2(t *testing.T) {
 	}
 }
 
+func TestFMA(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var x Decimal
+	var y Decimal
+	var z Decimal
+	var res testDataResult
+
+	for r.scan("fma(%v, %v, %v) = %v\n", &x, &y, &z, &res) {
+		for _, mode := range roundingModes {
+			fma := FMAWithMode(x, y, z, mode)
+
+			if !res.equal(fma, mode) {
+				t.Errorf("FMAWithMode(%v, %v, %v, %v) = %v, want %v", x, y, z, mode, fma, res.result(mode))
+			}
+		}
+	}
+}
+
+func TestDotFMA(t *testing.T) {
+	t.Parallel()
+
+	x := []Decimal{MustParse("1"), MustParse("1.000000000000000000000000000000001")}
+	y := []Decimal{MustParse("-1.000000000000000000000000000000002"), MustParse("1.000000000000000000000000000000001")}
+
+	if res, want := DotFMA(x, y), MustParse("1e-66"); !res.Equal(want) {
+		t.Errorf("DotFMA(%v, %v) = %v, want %v", x, y, res, want)
+	}
+
+	if res, want := Dot(x, y), MustParse("0"); !res.Equal(want) {
+		t.Errorf("Dot(%v, %v) = %v, want %v", x, y, res, want)
+	}
+}
+
 func TestDecimalAdd(t *testing.T) {
 	t.Parallel()
 
@@ -89,6 +126,27 @@ func TestDecimalPow(t *testing.T) {
 	}
 }
 
+func TestPowInt(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var n int64
+	var res testDataResult
+
+	for r.scan("powint(%v, %d) = %v\n", &val, &n, &res) {
+		for _, mode := range roundingModes {
+			pwr := PowIntWithMode(val, n, mode)
+
+			if !res.equal(pwr, mode) || pwr.IsFinite() && !pwr.IsZero() && !pwr.SameQuantum(res.result(mode)) {
+				t.Errorf("PowIntWithMode(%v, %d, %v) = %v, want %v", val, n, mode, pwr, res.result(mode))
+			}
+		}
+	}
+}
+
 func TestDecimalQuo(t *testing.T) {
 	t.Parallel()
 
@@ -133,6 +191,75 @@ func TestDecimalQuoRem(t *testing.T) {
 	}
 }
 
+func TestDecimalQuoRemEuclid(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var lhs Decimal
+	var rhs Decimal
+	var res testDataResultPair
+
+	res.sep = 'r'
+
+	for r.scan("%v / %v = %v\n", &lhs, &rhs, &res) {
+		quo, rem := lhs.QuoRemEuclid(rhs)
+
+		if !res.equal(quo, rem, ToNearestEven) {
+			t.Errorf("%v.QuoRemEuclid(%v) = (%v, %v), want (%v, %v)", lhs, rhs, quo, rem, res.first.result(ToNearestEven), res.second.result(ToNearestEven))
+		}
+
+		if mod := lhs.ModEuclid(rhs); !resultEqual(mod, rem) {
+			t.Errorf("%v.ModEuclid(%v) = %v, want %v", lhs, rhs, mod, rem)
+		}
+	}
+}
+
+func TestDecimalQuoRemFloor(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var lhs Decimal
+	var rhs Decimal
+	var res testDataResultPair
+
+	res.sep = 'r'
+
+	for r.scan("%v / %v = %v\n", &lhs, &rhs, &res) {
+		quo, rem := lhs.QuoRemFloor(rhs)
+
+		if !res.equal(quo, rem, ToNearestEven) {
+			t.Errorf("%v.QuoRemFloor(%v) = (%v, %v), want (%v, %v)", lhs, rhs, quo, rem, res.first.result(ToNearestEven), res.second.result(ToNearestEven))
+		}
+
+		if mod := lhs.Mod(rhs); !resultEqual(mod, rem) {
+			t.Errorf("%v.Mod(%v) = %v, want %v", lhs, rhs, mod, rem)
+		}
+	}
+}
+
+func TestRemainder(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var lhs Decimal
+	var rhs Decimal
+	var res Decimal
+
+	for r.scan("remainder(%v, %v) = %v\n", &lhs, &rhs, &res) {
+		rem := Remainder(lhs, rhs)
+
+		if !resultEqual(rem, res) || !rem.SameQuantum(res) {
+			t.Errorf("Remainder(%v, %v) = %v, want %v", lhs, rhs, rem, res)
+		}
+	}
+}
+
 func TestDecimalSub(t *testing.T) {
 	t.Parallel()
 
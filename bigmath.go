@@ -0,0 +1,668 @@
+package decimal128
+
+import "math/big"
+
+// The transcendental functions compute their results using decomposed192
+// arithmetic, which carries more than 40 correct digits. When a result lies
+// too close to a rounding boundary for its error bound to guarantee that it is
+// rounded correctly, it is computed again using big.Int fixed point
+// arithmetic, with the precision doubled until the rounding is certain.
+
+const (
+	// bigPrecision is the number of digits computed by the first big.Int
+	// attempt, and bigMaxPrecision the number of digits after which the
+	// attempts give up. Only results that are exactly on a rounding boundary
+	// can need more, and those are detected before giving up.
+	bigPrecision    = 50
+	bigMaxPrecision = 3200
+
+	// bigGuard is the number of guard digits carried by every big.Int
+	// computation. The error of a computation is less than
+	// 10**(bigGuard-bigGuardErr) units in its last place.
+	bigGuard    = 30
+	bigGuardErr = 10
+)
+
+var bigTen = big.NewInt(10)
+
+// reduceApprox rounds res, an approximation with a relative error of less
+// than 10**-digits, and reports whether every value within that error rounds
+// to the same result.
+func (rm rounding) reduceApprox(neg bool, res decomposed192, digits int) (uint128, int16, bool) {
+	if digits < 1 || digits >= len(uint192PowersOf10) || res.sig == (uint192{}) {
+		return uint128{}, 0, false
+	}
+
+	l10 := res.sig.log10()
+
+	for l10+1 < digits {
+		res.sig = res.sig.mul64(10)
+		res.exp--
+		l10++
+	}
+
+	err := uint192PowersOf10[l10+1-digits]
+
+	lo, brw := res.sig.sub(err)
+	if brw != 0 {
+		return uint128{}, 0, false
+	}
+
+	mode := rm.bounds()
+	loSig, loExp, _ := mode.reduce192(neg, lo, res.exp+exponentBias, 1, 0)
+	hiSig, hiExp, _ := mode.reduce256(neg, res.sig.add(err), res.exp+exponentBias, -1, 0)
+
+	if mode.RoundingMode != rm.RoundingMode && loSig == hiSig && loExp == hiExp {
+		sig, exp, _ := rm.reduce192(neg, res.sig, res.exp+exponentBias, 0, 0)
+		return sig, exp, true
+	}
+
+	return loSig, loExp, loSig == hiSig && loExp == hiExp
+}
+
+// bounds returns the rounding mode used to decide whether the bounds of an
+// approximation round to the same result. Stochastic rounding draws a new
+// random number for every result, so instead the approximation is rounded
+// once both bounds are truncated to the same result.
+func (rm rounding) bounds() rounding {
+	if rm.RoundingMode == Stochastic {
+		return rounding{RoundingMode: ToZero}
+	}
+
+	return rm
+}
+
+// expDigits returns the number of digits to which res, the result of an
+// exponential computed using decomposed192 arithmetic, is correct. The error
+// of an exponential grows with the magnitude of its argument.
+func expDigits(res decomposed192) int {
+	adj := res.sig.log10() + int(res.exp)
+	if adj < 0 {
+		adj = -adj
+	}
+
+	digits := 45
+
+	for n := adj + 2; n > 0; n /= 10 {
+		digits--
+	}
+
+	return digits
+}
+
+// logDigits returns the number of digits to which res, the result of a
+// logarithm computed using decomposed192 arithmetic, is correct. The error of
+// a logarithm is absolute for results less than one.
+func logDigits(res decomposed192) int {
+	adj := res.sig.log10() + int(res.exp)
+	if adj > 0 {
+		adj = 0
+	}
+
+	return 54 + adj
+}
+
+// reduceZiv rounds the value computed by f, which returns sig*10**exp with an
+// error of less than 10**(bigGuard-bigGuardErr) units in the last place of
+// sig when computing bigGuard digits more than prec. The precision is
+// increased until the value can be rounded correctly.
+func (rm rounding) reduceZiv(f func(prec int) (*big.Int, int)) (uint128, int16, bool) {
+	err := bigPow10(bigGuard - bigGuardErr)
+
+	for prec := bigPrecision; ; prec *= 2 {
+		sig, exp := f(prec + bigGuard)
+		neg := sig.Sign() < 0
+
+		res, resExp, ok := rm.reduceInterval(neg, sig.Abs(sig), err, exp)
+		if ok || prec >= bigMaxPrecision {
+			return res, resExp, neg
+		}
+	}
+}
+
+// reduceInterval rounds a value that lies strictly between (sig-err)*10**exp
+// and (sig+err)*10**exp, and reports whether every value in that interval
+// rounds to the same result.
+func (rm rounding) reduceInterval(neg bool, sig, err *big.Int, exp int) (uint128, int16, bool) {
+	lo := new(big.Int).Sub(sig, err)
+	if lo.Sign() <= 0 {
+		return uint128{}, 0, false
+	}
+
+	hi := new(big.Int).Add(sig, err)
+
+	mode := rm.bounds()
+	loSig, loExp, _ := mode.reduceBig(neg, lo, exp, 1)
+	hiSig, hiExp, _ := mode.reduceBig(neg, hi, exp, -1)
+
+	if mode.RoundingMode != rm.RoundingMode && loSig == hiSig && loExp == hiExp {
+		sig, exp, _ := rm.reduceBig(neg, sig, exp, 0)
+		return sig, exp, true
+	}
+
+	return loSig, loExp, loSig == hiSig && loExp == hiExp
+}
+
+// reduceBig rounds sig*10**exp, where sig is positive, in the same way as
+// reduce256. Results too large to be represented have an exponent greater
+// than maxBiasedExponent.
+func (rm rounding) reduceBig(neg bool, sig *big.Int, exp int, trunc int8) (uint128, int16, bool) {
+	if bl := sig.BitLen(); bl > 256 {
+		n := (bl-256)*30103/100000 + 1
+
+		var rem big.Int
+		sig, _ = new(big.Int).QuoRem(sig, bigPow10(n), &rem)
+		exp += n
+
+		if rem.Sign() != 0 {
+			trunc = 1
+		}
+	}
+
+	exp += exponentBias
+
+	if exp > maxBiasedExponent+maxDigits {
+		return uint128{}, maxBiasedExponent + 1, true
+	}
+
+	if exp < minBiasedExponent-2*maxDigits-10 {
+		return uint128{}, 0, true
+	}
+
+	var buf [32]byte
+	sig.FillBytes(buf[:])
+
+	var sig256 uint256
+	for i := range sig256 {
+		for _, b := range buf[32-8*(i+1) : 32-8*i] {
+			sig256[i] = sig256[i]<<8 | uint64(b)
+		}
+	}
+
+	return rm.reduce256(neg, sig256, int16(exp), trunc, 0)
+}
+
+// reduceNearOne rounds a value that differs from one by less than
+// 10**-(maxDigits+5), and is greater than one if above is true.
+func (rm rounding) reduceNearOne(above bool) (uint128, int16) {
+	trunc := int8(-1)
+	if above {
+		trunc = 1
+	}
+
+	sig, exp, _ := rm.reduce128(false, uint128PowersOf10[maxDigits], exponentBias-maxDigits, trunc, 0)
+	return sig, exp
+}
+
+// bigUint128 returns n as a big.Int.
+func bigUint128(n uint128) *big.Int {
+	i := new(big.Int).SetUint64(n[1])
+	i.Lsh(i, 64)
+
+	return i.Or(i, new(big.Int).SetUint64(n[0]))
+}
+
+// bigPow10 returns 10**n.
+func bigPow10(n int) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
+
+// bigShift returns x*10**n, truncated towards zero if n is negative.
+func bigShift(x *big.Int, n int) *big.Int {
+	if n >= 0 {
+		return new(big.Int).Mul(x, bigPow10(n))
+	}
+
+	return new(big.Int).Quo(x, bigPow10(-n))
+}
+
+// bigDigits returns the number of decimal digits of x.
+func bigDigits(x *big.Int) int {
+	n := (x.BitLen()-1)*30103/100000 + 1
+
+	if new(big.Int).Abs(x).Cmp(bigPow10(n)) >= 0 {
+		n++
+	}
+
+	return n
+}
+
+// bigAtanhInv returns atanh(1/k) with prec digits after the decimal point.
+func bigAtanhInv(k int64, prec int) *big.Int {
+	k2 := big.NewInt(k * k)
+	term := new(big.Int).Quo(bigPow10(prec), big.NewInt(k))
+	res := new(big.Int).Set(term)
+
+	var tmp big.Int
+	for i := int64(3); term.Sign() != 0; i += 2 {
+		term.Quo(term, k2)
+		res.Add(res, tmp.Quo(term, big.NewInt(i)))
+	}
+
+	return res
+}
+
+// bigLn2 returns ln(2) = 2*atanh(1/3) with prec digits after the decimal
+// point.
+func bigLn2(prec int) *big.Int {
+	res := bigAtanhInv(3, prec)
+	return res.Lsh(res, 1)
+}
+
+// bigLn10 returns ln(10) = 3*ln(2) + 2*atanh(1/9) with prec digits after the
+// decimal point.
+func bigLn10(prec int) *big.Int {
+	res := bigAtanhInv(9, prec)
+	res.Lsh(res, 1)
+
+	return res.Add(res, new(big.Int).Mul(bigLn2(prec), big.NewInt(3)))
+}
+
+// bigHalfPi returns π/2 with prec digits after the decimal point, computed
+// from the digits of 2/π, or with as many as those allow.
+func bigHalfPi(prec int) *big.Int {
+	n := min(prec+bigGuardErr, twoOverPiDigits)
+	t := bigTwoOverPi(0, n)
+
+	return t.Quo(bigPow10(n+prec), t)
+}
+
+// bigExp returns sig and exp such that e**z is sig*10**exp, where z has prec
+// digits after the decimal point and sig has prec+1 digits.
+func bigExp(z *big.Int, prec int) (*big.Int, int) {
+	// e**z = 10**n * e**r, where z = n*ln(10) + r and 0 <= r < ln(10).
+	var r big.Int
+	n, _ := new(big.Int).DivMod(bigShift(z, bigGuardErr), bigLn10(prec+bigGuardErr), &r)
+
+	// e**r = (e**(r/2**16))**(2**16), where the series of e**(r/2**16)
+	// converges quickly.
+	x := bigShift(&r, -bigGuardErr)
+	x.Rsh(x, 16)
+
+	one := bigPow10(prec)
+	res := new(big.Int).Set(one)
+	term := new(big.Int).Set(one)
+
+	for i := int64(1); term.Sign() != 0; i++ {
+		term.Mul(term, x)
+		term.Quo(term, one)
+		term.Quo(term, big.NewInt(i))
+		res.Add(res, term)
+	}
+
+	for i := 0; i < 16; i++ {
+		res.Mul(res, res)
+		res.Quo(res, one)
+	}
+
+	return res, int(n.Int64()) - prec
+}
+
+// bigLog returns ln(c*10**e), where c is positive, with prec digits after the
+// decimal point.
+func bigLog(c *big.Int, e, prec int) *big.Int {
+	// c*10**e = 2**j * 10**k * y, where 0.7 < y <= 1.4.
+	l10 := bigDigits(c) - 1
+	one := bigPow10(prec)
+	y := bigShift(c, prec-l10)
+
+	lim := new(big.Int).Mul(one, big.NewInt(14))
+	lim.Quo(lim, bigTen)
+
+	var j int64
+	for y.Cmp(lim) > 0 {
+		y.Rsh(y, 1)
+		j++
+	}
+
+	// ln(y) = 2*atanh(t), where t = (y-1)/(y+1).
+	t := new(big.Int).Sub(y, one)
+	t.Mul(t, one)
+	t.Quo(t, y.Add(y, one))
+
+	t2 := new(big.Int).Mul(t, t)
+	t2.Quo(t2, one)
+
+	res := new(big.Int).Set(t)
+	term := new(big.Int).Set(t)
+
+	var tmp big.Int
+	for i := int64(3); term.Sign() != 0; i += 2 {
+		term.Mul(term, t2)
+		term.Quo(term, one)
+		res.Add(res, tmp.Quo(term, big.NewInt(i)))
+	}
+
+	res = bigShift(res.Lsh(res, 1), bigGuardErr)
+	res.Add(res, tmp.Mul(bigLn2(prec+bigGuardErr), big.NewInt(j)))
+	res.Add(res, tmp.Mul(bigLn10(prec+bigGuardErr), big.NewInt(int64(e+l10))))
+
+	return bigShift(res, -bigGuardErr)
+}
+
+// bigSinCos returns sin(r) and cos(r), where |r| <= 1 and r and the results
+// have prec digits after the decimal point.
+func bigSinCos(r *big.Int, prec int) (*big.Int, *big.Int) {
+	one := bigPow10(prec + bigGuardErr)
+	x := bigShift(r, bigGuardErr)
+
+	sin := new(big.Int)
+	cos := new(big.Int).Set(one)
+	term := new(big.Int).Set(one)
+
+	// The terms of the series of e**ix = cos(x) + i*sin(x) are, in turn, added
+	// to and subtracted from the cosine and the sine.
+	for i := int64(1); term.Sign() != 0; i++ {
+		term.Mul(term, x)
+		term.Quo(term, one)
+		term.Quo(term, big.NewInt(i))
+
+		switch i % 4 {
+		case 0:
+			cos.Add(cos, term)
+		case 1:
+			sin.Add(sin, term)
+		case 2:
+			cos.Sub(cos, term)
+		case 3:
+			sin.Sub(sin, term)
+		}
+	}
+
+	return bigShift(sin, -bigGuardErr), bigShift(cos, -bigGuardErr)
+}
+
+// expBig returns b**d rounded correctly, where lnb returns ln(b) with the
+// given number of digits after the decimal point, or is nil if b is e.
+func (d Decimal) expBig(mode rounding, lnb func(int) *big.Int) (uint128, int16) {
+	dSig, dExp := d.decompose()
+	e := int(dExp) - exponentBias
+
+	if dSig.log10()+e < -maxDigits-5 {
+		return mode.reduceNearOne(!d.Signbit())
+	}
+
+	c := bigUint128(dSig)
+	if d.Signbit() {
+		c.Neg(c)
+	}
+
+	sig, exp, _ := mode.reduceZiv(func(prec int) (*big.Int, int) {
+		var z *big.Int
+		if lnb == nil {
+			z = bigShift(c, e+prec)
+		} else {
+			z = bigShift(new(big.Int).Mul(c, lnb(prec+bigGuardErr)), e-bigGuardErr)
+		}
+
+		return bigExp(z, prec)
+	})
+
+	return sig, exp
+}
+
+// logBig returns the base b logarithm of d, which is positive and not one,
+// rounded correctly, where lnb returns ln(b) with the given number of digits
+// after the decimal point, or is nil if b is e.
+func (d Decimal) logBig(mode rounding, lnb func(int) *big.Int) (uint128, int16, bool) {
+	dSig, dExp := d.decompose()
+	c := bigUint128(dSig)
+
+	return mode.reduceZiv(func(prec int) (*big.Int, int) {
+		// A logarithm can be as small as about 10**-maxDigits, so it is
+		// computed with that many more digits after the decimal point.
+		prec += maxDigits
+
+		res := bigLog(c, int(dExp)-exponentBias, prec)
+
+		if lnb != nil {
+			res = bigShift(res, prec)
+			res.Quo(res, lnb(prec))
+		}
+
+		return res, -prec
+	})
+}
+
+// sqrtBig returns the square root of d, which is positive, rounded correctly.
+func (d Decimal) sqrtBig(mode rounding) (uint128, int16, bool) {
+	dSig, dExp := d.decompose()
+	e := int(dExp) - exponentBias
+
+	// The root of c*10**(2*k) is computed with at least 36 digits so that
+	// any remainder only decides which way it is rounded.
+	c := bigUint128(dSig)
+	shift := 2*(maxDigits+1) - bigDigits(c)
+
+	if (e-shift)%2 != 0 {
+		shift++
+	}
+
+	c = bigShift(c, shift)
+	root := new(big.Int).Sqrt(c)
+
+	var trunc int8
+	if c.Cmp(new(big.Int).Mul(root, root)) != 0 {
+		trunc = 1
+	}
+
+	return mode.reduceBig(false, root, (e-shift)/2, trunc)
+}
+
+// powBig returns |d|**o rounded correctly, negated if neg is true, where |d|
+// is not one.
+func (d Decimal) powBig(o Decimal, neg bool, mode rounding) (uint128, int16, bool) {
+	dSig, dExp := d.decompose()
+	oSig, oExp := o.decompose()
+
+	c := bigUint128(dSig)
+	e := int(dExp) - exponentBias
+
+	oc := bigUint128(oSig)
+	if o.Signbit() {
+		oc.Neg(oc)
+	}
+
+	oe := int(oExp) - exponentBias
+
+	// As |ln(d)| is less than 10**5, d**o is then too close to one for
+	// anything but which way it is rounded to be affected.
+	if oSig.log10()+oe < -maxDigits-10 {
+		sig, exp := mode.reduceNearOne((Compare(Abs(d), one(false)) > 0) != o.Signbit())
+		return sig, exp, true
+	}
+
+	// The logarithm of d is multiplied by o, so it needs as many more
+	// digits after the decimal point as o has before it.
+	extra := oSig.log10() + 1 + oe
+	if extra < 0 {
+		extra = 0
+	}
+
+	err := bigPow10(bigGuard - bigGuardErr)
+
+	for prec := bigPrecision; ; prec *= 2 {
+		wp := prec + bigGuard
+
+		z := bigLog(c, e, wp+extra)
+		z = bigShift(z.Mul(z, oc), oe-extra)
+
+		sig, exp := bigExp(z, wp)
+
+		res, resExp, ok := mode.reduceInterval(neg, sig, err, exp)
+		if ok {
+			return res, resExp, true
+		}
+
+		if b, f, ok := bigNearest(sig, exp); ok && bigPowExact(b, f, c, e, oc, oe) {
+			return mode.reduceBig(neg, b, f, 0)
+		}
+
+		if prec >= bigMaxPrecision {
+			return res, resExp, true
+		}
+	}
+}
+
+// bigNearest returns the value closest to sig*10**exp that has no more than
+// one digit more than a Decimal, or fewer if it is subnormal. Such values are
+// the only ones that can be exactly on a rounding boundary.
+func bigNearest(sig *big.Int, exp int) (*big.Int, int, bool) {
+	n := bigDigits(sig) - maxDigits - 1
+	if min := minUnbiasedExponent - 1 - exp; n < min {
+		n = min
+	}
+
+	if n <= 0 {
+		return nil, 0, false
+	}
+
+	// Round half up by adding half a unit before truncating.
+	half := bigPow10(n)
+	half.Rsh(half, 1)
+
+	b := bigShift(new(big.Int).Add(sig, half), -n)
+	if b.Sign() == 0 {
+		return nil, 0, false
+	}
+
+	return b, exp + n, true
+}
+
+// bigPowExact reports whether (c*10**e)**(oc*10**oe) is exactly b*10**f.
+func bigPowExact(b *big.Int, f int, c *big.Int, e int, oc *big.Int, oe int) bool {
+	b = new(big.Int).Set(b)
+
+	var q, r big.Int
+	for {
+		q.QuoRem(b, bigTen, &r)
+		if r.Sign() != 0 {
+			break
+		}
+
+		b.Set(&q)
+		f++
+	}
+
+	// o = m/n, in lowest terms.
+	m := new(big.Int).Set(oc)
+	n := big.NewInt(1)
+
+	if oe >= 0 {
+		m = bigShift(m, oe)
+	} else {
+		n = bigPow10(-oe)
+		g := new(big.Int).GCD(nil, nil, new(big.Int).Abs(m), n)
+		m.Quo(m, g)
+		n.Quo(n, g)
+	}
+
+	// Every prime factor of c*10**e must occur a multiple of n times for the
+	// result to be rational, which limits n to the number of factors of two
+	// in the smallest or largest Decimal.
+	if !n.IsInt64() || n.Int64() > 25_000 || !m.IsInt64() {
+		return false
+	}
+
+	const maxBits = 1 << 22
+
+	nn, mm := n.Int64(), m.Int64()
+
+	absm := mm
+	if absm < 0 {
+		absm = -absm
+	}
+
+	if absm > maxBits {
+		return false
+	}
+
+	// b**n * 10**(f*n) == c**m * 10**(e*m).
+	k := int64(e)*mm - int64(f)*nn
+
+	if nn*int64(b.BitLen()) > maxBits || absm*int64(c.BitLen()) > maxBits || k > maxBits || k < -maxBits {
+		return false
+	}
+
+	lhs := new(big.Int).Exp(b, n, nil)
+	rhs := new(big.Int).Exp(c, big.NewInt(absm), nil)
+
+	if mm < 0 {
+		// b**n * c**-m == 10**k
+		lhs.Mul(lhs, rhs)
+		rhs.SetInt64(1)
+	}
+
+	if k > 0 {
+		rhs.Mul(rhs, bigPow10(int(k)))
+	} else {
+		lhs.Mul(lhs, bigPow10(int(-k)))
+	}
+
+	return lhs.Cmp(rhs) == 0
+}
+
+// trigBig returns the sine, cosine or tangent of d, which is finite and not
+// zero, rounded correctly.
+func (d Decimal) trigBig(fn trigFunc, mode rounding) (uint128, int16, bool) {
+	dSig, dExp := d.decompose()
+	c := bigUint128(dSig)
+	e := int(dExp) - exponentBias
+	adj := dSig.log10() + e
+
+	return mode.reduceZiv(func(prec int) (*big.Int, int) {
+		// |d| = (4*k + q) * π/2 + r, where r has z zeros after the decimal
+		// point. It is computed with that many more digits, so that the
+		// sine of a small r has prec digits.
+		var q, z int
+		var r *big.Int
+
+		if adj >= 0 {
+			var f *big.Int
+			var n int
+
+			q, f, n = trigReduce(c, e, prec+bigGuardErr)
+			z = max(n-bigDigits(f), 0)
+
+			m := prec + z + bigGuardErr
+			r = bigShift(new(big.Int).Mul(f, bigHalfPi(m)), -n-bigGuardErr)
+		} else {
+			z = -adj - 1
+			r = bigShift(c, prec+z+e)
+		}
+
+		m := prec + z
+		sin, cos := bigSinCos(r, m)
+
+		if fn == trigCos {
+			q++
+		}
+
+		res, exp := sin, -m
+
+		switch {
+		case fn == trigTan && q%2 == 0:
+			res = bigShift(sin, m)
+			res.Quo(res, cos)
+		case fn == trigTan:
+			// The cotangent of r is as large as 10**z, so it is computed
+			// with 2*z fewer digits after the decimal point.
+			res = bigShift(cos, m-2*z)
+			res.Quo(res, sin)
+			res.Neg(res)
+			exp = 2*z - m
+		case q%2 != 0:
+			res = cos
+		}
+
+		if fn != trigTan && q%4 >= 2 {
+			res.Neg(res)
+		}
+
+		if d.Signbit() && fn != trigCos {
+			res.Neg(res)
+		}
+
+		return res, exp
+	})
+}
@@ -0,0 +1,435 @@
+package decimal128
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+// The oracle below evaluates the functions with math/big at a precision far
+// beyond what decimal128 needs, and checks that each result is the correctly
+// rounded value in every rounding mode. The oracle shares no code with the
+// package: it works in binary floating point, and rounds the result exactly
+// with big.Rat.
+
+const oraclePrec = 1024
+
+func oracleFloat(d Decimal) *big.Float {
+	r, ok := new(big.Rat).SetString(d.String())
+	if !ok {
+		panic("decimal128: cannot convert " + d.String())
+	}
+
+	return new(big.Float).SetPrec(oraclePrec).SetRat(r)
+}
+
+func oracleExp(x *big.Float) *big.Float {
+	// Halve x until it is small, sum the Taylor series and square the
+	// result back up.
+	k := max(x.MantExp(nil)+64, 0)
+	r := new(big.Float).SetPrec(oraclePrec).SetMantExp(x, -k)
+
+	sum := new(big.Float).SetPrec(oraclePrec).SetInt64(1)
+	term := new(big.Float).SetPrec(oraclePrec).SetInt64(1)
+	for i := int64(1); ; i++ {
+		term.Mul(term, r)
+		term.Quo(term, new(big.Float).SetInt64(i))
+		if term.Sign() == 0 || term.MantExp(nil) < -oraclePrec-16 {
+			break
+		}
+
+		sum.Add(sum, term)
+	}
+
+	for i := 0; i < k; i++ {
+		sum.Mul(sum, sum)
+	}
+
+	return sum
+}
+
+func oracleLog(x *big.Float) *big.Float {
+	mant := new(big.Float)
+	exp := x.MantExp(mant)
+	f, _ := mant.Float64()
+	y := new(big.Float).SetPrec(oraclePrec).SetFloat64(math.Log(f) + float64(exp)*math.Ln2)
+
+	// Halley's iteration y += 2(x - e**y)/(x + e**y) triples the number of
+	// correct bits each step.
+	for i := 0; i < 5; i++ {
+		e := oracleExp(y)
+		num := new(big.Float).SetPrec(oraclePrec).Sub(x, e)
+		den := new(big.Float).SetPrec(oraclePrec).Add(x, e)
+		num.Quo(num, den)
+		y.Add(y, num.Mul(num, big.NewFloat(2)))
+	}
+
+	return y
+}
+
+// oraclePi returns π with prec bits, using the Gauss–Legendre algorithm.
+func oraclePi(prec uint) *big.Float {
+	newFloat := func() *big.Float {
+		return new(big.Float).SetPrec(prec)
+	}
+
+	a := newFloat().SetInt64(1)
+	b := newFloat().Sqrt(newFloat().SetFloat64(0.5))
+	t := newFloat().SetFloat64(0.25)
+	p := newFloat().SetInt64(1)
+
+	for i := 0; i < 20; i++ {
+		an := newFloat().Add(a, b)
+		an.Quo(an, newFloat().SetInt64(2))
+		b.Sqrt(newFloat().Mul(a, b))
+
+		d := newFloat().Sub(a, an)
+		t.Sub(t, d.Mul(d.Mul(d, d), p))
+		p.Add(p, p)
+		a = an
+	}
+
+	res := newFloat().Add(a, b)
+	res.Mul(res, res)
+
+	return res.Quo(res, t.Mul(t, newFloat().SetInt64(4)))
+}
+
+// oracleSinCos returns the sine and cosine of d. The argument is reduced
+// exactly, with as many bits of π as the integer part of d has and
+// oraclePrec more.
+func oracleSinCos(d Decimal) (*big.Float, *big.Float) {
+	x := oracleRat(d)
+	neg := x.Sign() < 0
+	x.Abs(x)
+
+	k := new(big.Int)
+	r := new(big.Float).SetPrec(oraclePrec).SetRat(x)
+
+	if e := r.MantExp(nil); e > 0 {
+		prec := uint(e) + 2*oraclePrec
+		halfPi := oraclePi(prec)
+		halfPi.SetMantExp(halfPi, -1)
+
+		xf := new(big.Float).SetPrec(prec).SetRat(x)
+		q := new(big.Float).SetPrec(prec).Quo(xf, halfPi)
+		q.Add(q, big.NewFloat(0.5))
+		q.Int(k)
+
+		xf.Sub(xf, halfPi.Mul(halfPi, new(big.Float).SetInt(k)))
+		r.Set(xf)
+	}
+
+	sin := new(big.Float).SetPrec(oraclePrec)
+	cos := new(big.Float).SetPrec(oraclePrec).SetInt64(1)
+	term := new(big.Float).SetPrec(oraclePrec).SetInt64(1)
+
+	for i := int64(1); ; i++ {
+		term.Mul(term, r)
+		term.Quo(term, new(big.Float).SetInt64(i))
+		if term.Sign() == 0 || term.MantExp(nil) < r.MantExp(nil)-2*oraclePrec {
+			break
+		}
+
+		switch i % 4 {
+		case 0:
+			cos.Add(cos, term)
+		case 1:
+			sin.Add(sin, term)
+		case 2:
+			cos.Sub(cos, term)
+		case 3:
+			sin.Sub(sin, term)
+		}
+	}
+
+	// |d| = k*π/2 + r.
+	switch new(big.Int).And(k, big.NewInt(3)).Int64() {
+	case 1:
+		sin, cos = cos, sin.Neg(sin)
+	case 2:
+		sin, cos = sin.Neg(sin), cos.Neg(cos)
+	case 3:
+		sin, cos = cos.Neg(cos), sin
+	}
+
+	if neg {
+		sin.Neg(sin)
+	}
+
+	return sin, cos
+}
+
+func oracleRat(d Decimal) *big.Rat {
+	r, _ := new(big.Rat).SetString(d.String())
+	return r
+}
+
+func oraclePow10(n int) *big.Rat {
+	if n < 0 {
+		return new(big.Rat).Inv(oraclePow10(-n))
+	}
+
+	return new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil))
+}
+
+// oracleLimit is the largest coefficient a decimal128 can hold. Results are
+// rounded to 35 digits when they fit below it and to 34 digits otherwise.
+var oracleLimit, _ = new(big.Int).SetString("0x2_7fff_ffff_ffff_ffff_ffff_ffff_ffff", 0)
+
+// oracleTrunc returns the coefficient and exponent of |v| truncated to the
+// precision decimal128 rounds to.
+func oracleTrunc(v *big.Rat) (*big.Int, int) {
+	v = new(big.Rat).Abs(v)
+
+	f, _ := v.Float64()
+	exp := int(math.Floor(math.Log10(f))) - 34
+	if math.IsInf(f, 0) || f == 0 {
+		e := new(big.Float).SetRat(v).MantExp(nil)
+		exp = int(float64(e)*math.Log10(2)) - 34
+	}
+
+	exp = max(exp, minUnbiasedExponent)
+
+	trunc := func() *big.Int {
+		q := new(big.Rat).Quo(v, oraclePow10(exp))
+		return new(big.Int).Quo(q.Num(), q.Denom())
+	}
+
+	sig := trunc()
+	for sig.Cmp(oracleLimit) > 0 {
+		exp++
+		sig = trunc()
+	}
+
+	for exp > minUnbiasedExponent {
+		exp--
+		if sig = trunc(); sig.Cmp(oracleLimit) > 0 {
+			exp++
+			sig = trunc()
+			break
+		}
+	}
+
+	return sig, exp
+}
+
+// checkRounded reports whether res is v rounded in the given mode. It returns
+// false for the second value when v is too close to a representable value or
+// a midpoint between two for the oracle to decide.
+func checkRounded(v *big.Float, res Decimal, mode RoundingMode) (ok, decided bool) {
+	vr, _ := v.Rat(nil)
+	neg := vr.Sign() < 0
+
+	sig, exp := oracleTrunc(vr)
+	ulp := oraclePow10(exp)
+	lo := new(big.Rat).Mul(new(big.Rat).SetInt(sig), ulp)
+	hi := new(big.Rat).Add(lo, ulp)
+
+	eps := new(big.Rat).Mul(ulp, oraclePow10(-50))
+	mid := new(big.Rat).Add(lo, new(big.Rat).Mul(ulp, big.NewRat(1, 2)))
+	av := new(big.Rat).Abs(vr)
+	for _, b := range []*big.Rat{lo, mid, hi} {
+		if dist := new(big.Rat).Sub(av, b); dist.Abs(dist).Cmp(eps) <= 0 {
+			return false, false
+		}
+	}
+
+	away := func() bool {
+		switch mode {
+		case ToNearestEven, ToNearestAway, ToNearestTowardZero, ToNearestOdd:
+			return av.Cmp(mid) > 0
+		case ToZero:
+			return false
+		case AwayFromZero:
+			return true
+		case ToNegativeInf:
+			return neg
+		case ToPositiveInf:
+			return !neg
+		case ToZero05Up:
+			digit := new(big.Int).Mod(sig, big.NewInt(10)).Int64()
+			return digit == 0 || digit == 5
+		}
+
+		panic("decimal128: unknown rounding mode")
+	}
+
+	want := lo
+	if away() {
+		want = hi
+	}
+
+	if neg {
+		want = new(big.Rat).Neg(want)
+	}
+
+	return want.Cmp(oracleRat(res)) == 0, true
+}
+
+func randDecimal(r *rand.Rand, minExp, maxExp int) Decimal {
+	n := 1 + r.Intn(maxPrecision)
+
+	sig := new(big.Int)
+	for i := 0; i < n; i++ {
+		sig.Mul(sig, big.NewInt(10))
+		sig.Add(sig, big.NewInt(r.Int63n(10)))
+	}
+
+	if sig.Sign() == 0 {
+		sig.SetInt64(1)
+	}
+
+	exp := minExp + r.Intn(maxExp-minExp+1) - (bigDigits(sig) - 1)
+	d := FromInt(sig)
+	d = d.Mul(New(1, exp))
+
+	return d
+}
+
+func TestOracle(t *testing.T) {
+	t.Parallel()
+
+	ln2 := oracleLog(new(big.Float).SetPrec(oraclePrec).SetInt64(2))
+	ln10 := oracleLog(new(big.Float).SetPrec(oraclePrec).SetInt64(10))
+
+	mul := func(x, y *big.Float) *big.Float {
+		return new(big.Float).SetPrec(oraclePrec).Mul(x, y)
+	}
+
+	quo := func(x, y *big.Float) *big.Float {
+		return new(big.Float).SetPrec(oraclePrec).Quo(x, y)
+	}
+
+	type unary struct {
+		name   string
+		fn     func(Decimal, RoundingMode) Decimal
+		oracle func(*big.Float) *big.Float
+		minExp int
+		maxExp int
+	}
+
+	unaries := []unary{
+		{"ExpWithMode", ExpWithMode, oracleExp, -40, 3},
+		{"Exp10WithMode", Exp10WithMode, func(x *big.Float) *big.Float { return oracleExp(mul(x, ln10)) }, -40, 2},
+		{"Exp2WithMode", Exp2WithMode, func(x *big.Float) *big.Float { return oracleExp(mul(x, ln2)) }, -40, 3},
+		{"LogWithMode", LogWithMode, oracleLog, -6000, 6000},
+		{"Log10WithMode", Log10WithMode, func(x *big.Float) *big.Float { return quo(oracleLog(x), ln10) }, -6000, 6000},
+		{"Log2WithMode", Log2WithMode, func(x *big.Float) *big.Float { return quo(oracleLog(x), ln2) }, -6000, 6000},
+		{"SqrtWithMode", SqrtWithMode, func(x *big.Float) *big.Float { return new(big.Float).SetPrec(oraclePrec).Sqrt(x) }, -6000, 6000},
+	}
+
+	for _, u := range unaries {
+		r := rand.New(rand.NewSource(2))
+		skipped := 0
+
+		for i := 0; i < 100; i++ {
+			val := randDecimal(r, u.minExp, u.maxExp)
+			if u.minExp < 0 && u.maxExp < 10 && r.Intn(2) == 0 {
+				val = val.Neg()
+			}
+
+			want := u.oracle(oracleFloat(val))
+
+			for _, mode := range roundingModes {
+				res := u.fn(val, mode)
+				if res.IsInf(0) || res.IsZero() {
+					continue
+				}
+
+				ok, decided := checkRounded(want, res, mode)
+				if !decided {
+					skipped++
+					continue
+				}
+
+				if !ok {
+					t.Errorf("%s(%v, %v) = %v, want %v", u.name, val, mode, res, want.Text('e', 40))
+				}
+			}
+		}
+
+		if skipped > 10 {
+			t.Errorf("%s: oracle could not decide %d results", u.name, skipped)
+		}
+	}
+
+	r := rand.New(rand.NewSource(3))
+	skipped := 0
+
+	for i := 0; i < 100; i++ {
+		lhs := randDecimal(r, -20, 20)
+		rhs := randDecimal(r, -10, 2)
+		if r.Intn(2) == 0 {
+			rhs = rhs.Neg()
+		}
+
+		want := oracleExp(mul(oracleFloat(rhs), oracleLog(oracleFloat(lhs))))
+
+		for _, mode := range roundingModes {
+			res := lhs.PowWithMode(rhs, mode)
+			if res.IsInf(0) || res.IsZero() {
+				continue
+			}
+
+			ok, decided := checkRounded(want, res, mode)
+			if !decided {
+				skipped++
+				continue
+			}
+
+			if !ok {
+				t.Errorf("%v.PowWithMode(%v, %v) = %v, want %v", lhs, rhs, mode, res, want.Text('e', 40))
+			}
+		}
+	}
+
+	if skipped > 10 {
+		t.Errorf("PowWithMode: oracle could not decide %d results", skipped)
+	}
+
+	trigs := []struct {
+		name   string
+		fn     func(Decimal, RoundingMode) Decimal
+		oracle func(sin, cos *big.Float) *big.Float
+	}{
+		{"SinWithMode", SinWithMode, func(sin, _ *big.Float) *big.Float { return sin }},
+		{"CosWithMode", CosWithMode, func(_, cos *big.Float) *big.Float { return cos }},
+		{"TanWithMode", TanWithMode, quo},
+	}
+
+	r = rand.New(rand.NewSource(5))
+	skipped = 0
+
+	for i := 0; i < 100; i++ {
+		val := randDecimal(r, -30, 6000)
+		if r.Intn(2) == 0 {
+			val = val.Neg()
+		}
+
+		sin, cos := oracleSinCos(val)
+
+		for _, trig := range trigs {
+			want := trig.oracle(sin, cos)
+
+			for _, mode := range roundingModes {
+				res := trig.fn(val, mode)
+
+				ok, decided := checkRounded(want, res, mode)
+				if !decided {
+					skipped++
+					continue
+				}
+
+				if !ok {
+					t.Errorf("%s(%v, %v) = %v, want %v", trig.name, val, mode, res, want.Text('e', 40))
+				}
+			}
+		}
+	}
+
+	if skipped > 10 {
+		t.Errorf("trigonometric functions: oracle could not decide %d results", skipped)
+	}
+}
@@ -0,0 +1,136 @@
+package decimal128
+
+import "fmt"
+
+// Class is the IEEE 754 class of a Decimal value, as returned by
+// [Decimal.Class].
+type Class uint8
+
+const (
+	SignalingNaN      Class = iota // == IEEE 754 signalingNaN
+	QuietNaN                       // == IEEE 754 quietNaN
+	NegativeInfinity               // == IEEE 754 negativeInfinity
+	NegativeNormal                 // == IEEE 754 negativeNormal
+	NegativeSubnormal              // == IEEE 754 negativeSubnormal
+	NegativeZero                   // == IEEE 754 negativeZero
+	PositiveZero                   // == IEEE 754 positiveZero
+	PositiveSubnormal              // == IEEE 754 positiveSubnormal
+	PositiveNormal                 // == IEEE 754 positiveNormal
+	PositiveInfinity               // == IEEE 754 positiveInfinity
+)
+
+// String returns a string representation of the class.
+func (c Class) String() string {
+	switch c {
+	case SignalingNaN:
+		return "SignalingNaN"
+	case QuietNaN:
+		return "QuietNaN"
+	case NegativeInfinity:
+		return "NegativeInfinity"
+	case NegativeNormal:
+		return "NegativeNormal"
+	case NegativeSubnormal:
+		return "NegativeSubnormal"
+	case NegativeZero:
+		return "NegativeZero"
+	case PositiveZero:
+		return "PositiveZero"
+	case PositiveSubnormal:
+		return "PositiveSubnormal"
+	case PositiveNormal:
+		return "PositiveNormal"
+	case PositiveInfinity:
+		return "PositiveInfinity"
+	default:
+		return fmt.Sprintf("Class(%d)", uint8(c))
+	}
+}
+
+// Class returns the IEEE 754 class of d.
+func (d Decimal) Class() Class {
+	if d.isSpecial() {
+		if d.IsNaN() {
+			if d.isSignaling() {
+				return SignalingNaN
+			}
+
+			return QuietNaN
+		}
+
+		if d.Signbit() {
+			return NegativeInfinity
+		}
+
+		return PositiveInfinity
+	}
+
+	sig, exp := d.decompose()
+	neg := d.Signbit()
+
+	switch {
+	case sig == (uint128{}):
+		if neg {
+			return NegativeZero
+		}
+
+		return PositiveZero
+	case int(exp)-exponentBias+sig.log10() < minAdjustedExponent:
+		if neg {
+			return NegativeSubnormal
+		}
+
+		return PositiveSubnormal
+	default:
+		if neg {
+			return NegativeNormal
+		}
+
+		return PositiveNormal
+	}
+}
+
+// IsCanonical reports whether d is encoded using its canonical encoding as
+// defined by IEEE 754. Finite values are canonical when their coefficient is
+// at most 10**34-1, infinities when all bits after the combination field are
+// 0, and NaN values when their payload is less than 10**33 and the reserved
+// bits of the combination field are 0.
+//
+// To keep as much precision as the encoding allows, arithmetic and
+// [Decimal.Compose] can return finite values with a coefficient from 10**34
+// up to 12980742146337069071326240823050239. Other implementations of IEEE
+// 754 decode these coefficients as 0, so such values are not canonical even
+// though this package produced them. [NewFromCoefficient] never returns
+// them.
+func (d Decimal) IsCanonical() bool {
+	if d.isSpecial() {
+		if d.IsNaN() {
+			payload := uint128{d.lo, d.hi & 0x0000_3fff_ffff_ffff}
+			return d.hi&0x01ff_c000_0000_0000 == 0 && payload.cmp(uint128PowersOf10[maxPrecision-1]) < 0
+		}
+
+		return d.hi&0x03ff_ffff_ffff_ffff == 0 && d.lo == 0
+	}
+
+	sig, _ := d.decompose()
+	return sig.cmp(uint128PowersOf10[maxPrecision]) < 0
+}
+
+// IsFinite reports whether d is neither infinite nor NaN.
+func (d Decimal) IsFinite() bool {
+	return !d.isSpecial()
+}
+
+// IsNormal reports whether d is a finite, non-zero value that is not
+// subnormal.
+func (d Decimal) IsNormal() bool {
+	c := d.Class()
+	return c == NegativeNormal || c == PositiveNormal
+}
+
+// IsSubnormal reports whether d is a non-zero value with a magnitude less than
+// 1E-6143, the smallest normal magnitude.
+func (d Decimal) IsSubnormal() bool {
+	c := d.Class()
+	return c == NegativeSubnormal || c == PositiveSubnormal
+}
@@ -0,0 +1,82 @@
+package decimal128
+
+import "testing"
+
+func TestClassString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		class Class
+		want  string
+	}{
+		{SignalingNaN, "SignalingNaN"},
+		{QuietNaN, "QuietNaN"},
+		{NegativeSubnormal, "NegativeSubnormal"},
+		{PositiveInfinity, "PositiveInfinity"},
+		{PositiveInfinity + 1, "Class(10)"},
+	}
+
+	for _, test := range tests {
+		if res := test.class.String(); res != test.want {
+			t.Errorf("Class(%d).String() = %s, want %s", uint8(test.class), res, test.want)
+		}
+	}
+}
+
+func TestDecimalClass(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		val       Decimal
+		class     Class
+		canonical bool
+	}{
+		{MustParse("NaN"), QuietNaN, true},
+		{MustParse("-NaN"), QuietNaN, true},
+		{Decimal{1, 0x7e00_0000_0000_0000}, SignalingNaN, true},
+		{Decimal{0, 0x7c00_4000_0000_0000}, QuietNaN, false},
+		{Decimal{0, 0x7c00_3fff_ffff_ffff}, QuietNaN, false},
+		{Decimal{0, 0x7d00_0000_0000_0000}, QuietNaN, false},
+		{MustParse("Inf"), PositiveInfinity, true},
+		{MustParse("-Inf"), NegativeInfinity, true},
+		{Decimal{1, 0x7800_0000_0000_0000}, PositiveInfinity, false},
+		{Decimal{0, 0xfa00_0000_0000_0000}, NegativeInfinity, false},
+		{MustParse("0"), PositiveZero, true},
+		{MustParse("-0E+10"), NegativeZero, true},
+		{MustParse("1"), PositiveNormal, true},
+		{MustParse("-123.456"), NegativeNormal, true},
+		{MustParse("1E-6143"), PositiveNormal, true},
+		{MustParse("9.99999999999999999999999999999999E-6144"), PositiveSubnormal, true},
+		{MustParse("-1E-6176"), NegativeSubnormal, true},
+		{MustParse("9.999999999999999999999999999999999E+6144"), PositiveNormal, true},
+		{Decimal{0, 0x3040_0000_0000_0000 | 0x0001_ed09_bead_87c0 + 1}, PositiveNormal, false},
+		{Decimal{0, 0xe000_0000_0000_0000}, NegativeNormal, false},
+		{New(1, 0).Quo(New(9, 0)), PositiveNormal, false},
+		{New(1, 0).Quo(New(3, 0)), PositiveNormal, true},
+	}
+
+	for _, test := range tests {
+		if res := test.val.Class(); res != test.class {
+			t.Errorf("%v.Class() = %v, want %v", test.val, res, test.class)
+		}
+
+		if res := test.val.IsCanonical(); res != test.canonical {
+			t.Errorf("%v.IsCanonical() = %t, want %t", test.val, res, test.canonical)
+		}
+
+		finite := test.class > NegativeInfinity && test.class < PositiveInfinity
+		if res := test.val.IsFinite(); res != finite {
+			t.Errorf("%v.IsFinite() = %t, want %t", test.val, res, finite)
+		}
+
+		normal := test.class == NegativeNormal || test.class == PositiveNormal
+		if res := test.val.IsNormal(); res != normal {
+			t.Errorf("%v.IsNormal() = %t, want %t", test.val, res, normal)
+		}
+
+		subnormal := test.class == NegativeSubnormal || test.class == PositiveSubnormal
+		if res := test.val.IsSubnormal(); res != subnormal {
+			t.Errorf("%v.IsSubnormal() = %t, want %t", test.val, res, subnormal)
+		}
+	}
+}
@@ -27,11 +27,11 @@ func Compare(d, o Decimal) int {
 // Max returns the larger of d or o. If either value is NaN the result is NaN.
 func Max(d, o Decimal) Decimal {
 	if d.IsNaN() {
-		return d
+		return d.quiet()
 	}
 
 	if o.IsNaN() {
-		return o
+		return o.quiet()
 	}
 
 	if d.IsZero() && o.IsZero() {
@@ -52,11 +52,11 @@ func Max(d, o Decimal) Decimal {
 // Min returns the smaller of d or o. If either value is NaN the result is NaN.
 func Min(d, o Decimal) Decimal {
 	if d.IsNaN() {
-		return d
+		return d.quiet()
 	}
 
 	if o.IsNaN() {
-		return o
+		return o.quiet()
 	}
 
 	if d.IsZero() && o.IsZero() {
@@ -74,6 +74,214 @@ func Min(d, o Decimal) Decimal {
 	return d
 }
 
+// Maximum returns the larger of d or o using the IEEE 754 maximum operation.
+// If either value is NaN the result is NaN, with the payload of the first
+// signaling NaN or, if there is none, the first quiet NaN. -0 is considered
+// to be less than +0.
+func Maximum(d, o Decimal) Decimal {
+	if d.IsNaN() || o.IsNaN() {
+		return propagateNaN(d, o)
+	}
+
+	return Max(d, o)
+}
+
+// Minimum returns the smaller of d or o using the IEEE 754 minimum operation.
+// If either value is NaN the result is NaN, with the payload of the first
+// signaling NaN or, if there is none, the first quiet NaN. -0 is considered
+// to be less than +0.
+func Minimum(d, o Decimal) Decimal {
+	if d.IsNaN() || o.IsNaN() {
+		return propagateNaN(d, o)
+	}
+
+	return Min(d, o)
+}
+
+// MaxNum returns the larger of d or o using the IEEE 754 maximumNumber
+// operation. If exactly one value is NaN, quiet or signaling, the other value
+// is returned. If both values are NaN the result is NaN.
+func MaxNum(d, o Decimal) Decimal {
+	if d.IsNaN() || o.IsNaN() {
+		return numNaN(d, o)
+	}
+
+	return Max(d, o)
+}
+
+// MinNum returns the smaller of d or o using the IEEE 754 minimumNumber
+// operation. If exactly one value is NaN, quiet or signaling, the other value
+// is returned. If both values are NaN the result is NaN.
+func MinNum(d, o Decimal) Decimal {
+	if d.IsNaN() || o.IsNaN() {
+		return numNaN(d, o)
+	}
+
+	return Min(d, o)
+}
+
+// MaxMag returns the value of d or o with the larger magnitude using the IEEE
+// 754 maximumMagnitude operation. If the magnitudes are equal the result is
+// [Maximum](d, o). If either value is NaN the result is NaN.
+func MaxMag(d, o Decimal) Decimal {
+	if d.IsNaN() || o.IsNaN() {
+		return propagateNaN(d, o)
+	}
+
+	switch d.CmpAbs(o) {
+	case cmpGreater:
+		return d
+	case cmpLess:
+		return o
+	}
+
+	return Max(d, o)
+}
+
+// MinMag returns the value of d or o with the smaller magnitude using the IEEE
+// 754 minimumMagnitude operation. If the magnitudes are equal the result is
+// [Minimum](d, o). If either value is NaN the result is NaN.
+func MinMag(d, o Decimal) Decimal {
+	if d.IsNaN() || o.IsNaN() {
+		return propagateNaN(d, o)
+	}
+
+	switch d.CmpAbs(o) {
+	case cmpLess:
+		return d
+	case cmpGreater:
+		return o
+	}
+
+	return Min(d, o)
+}
+
+// MaxMagNum returns the value of d or o with the larger magnitude using the
+// IEEE 754 maximumMagnitudeNumber operation. If exactly one value is NaN,
+// quiet or signaling, the other value is returned. If both values are NaN the
+// result is NaN.
+func MaxMagNum(d, o Decimal) Decimal {
+	if d.IsNaN() || o.IsNaN() {
+		return numNaN(d, o)
+	}
+
+	return MaxMag(d, o)
+}
+
+// MinMagNum returns the value of d or o with the smaller magnitude using the
+// IEEE 754 minimumMagnitudeNumber operation. If exactly one value is NaN,
+// quiet or signaling, the other value is returned. If both values are NaN the
+// result is NaN.
+func MinMagNum(d, o Decimal) Decimal {
+	if d.IsNaN() || o.IsNaN() {
+		return numNaN(d, o)
+	}
+
+	return MinMag(d, o)
+}
+
+// numNaN returns the result of a *Num operation where at least one of d or o
+// is NaN: the operand that is not NaN, or a quiet NaN if both are.
+func numNaN(d, o Decimal) Decimal {
+	if !d.IsNaN() {
+		return d
+	}
+
+	if !o.IsNaN() {
+		return o
+	}
+
+	return propagateNaN(d, o)
+}
+
+// TotalOrder compares d and o using the IEEE 754 totalOrder predicate and
+// returns:
+//
+//	-1 if d orders below o
+//	 0 if d and o have the same representation
+//	+1 if d orders above o
+//
+// Unlike [Compare], TotalOrder distinguishes every value: -0 orders below +0,
+// members of a cohort such as 1.0 and 1.00 are ordered by exponent, and NaN
+// values are ordered by sign, then with signaling NaNs closer to zero than
+// quiet NaNs, then by payload. The resulting order is:
+//
+//	-NaN < -sNaN < -Inf < -finite < -0 < +0 < +finite < +Inf < +sNaN < +NaN
+func TotalOrder(d, o Decimal) int {
+	dNeg := d.Signbit()
+
+	if dNeg != o.Signbit() {
+		if dNeg {
+			return -1
+		}
+
+		return 1
+	}
+
+	if dNeg {
+		return -TotalOrderMag(d, o)
+	}
+
+	return TotalOrderMag(d, o)
+}
+
+// TotalOrderMag is like [TotalOrder], but compares the absolute values of d
+// and o.
+func TotalOrderMag(d, o Decimal) int {
+	if d.isSpecial() || o.isSpecial() {
+		dRank, oRank := d.totalRank(), o.totalRank()
+
+		if dRank != oRank {
+			if dRank < oRank {
+				return -1
+			}
+
+			return 1
+		}
+
+		if !d.IsNaN() {
+			return 0
+		}
+
+		if res := d.nanPayload().cmp(o.nanPayload()); res != 0 {
+			return res
+		}
+
+		return uint128{d.lo, d.hi & 0x0000_3fff_ffff_ffff}.cmp(uint128{o.lo, o.hi & 0x0000_3fff_ffff_ffff})
+	}
+
+	if res := int(d.CmpAbs(o)); res != 0 {
+		return res
+	}
+
+	_, dExp := d.decompose()
+	_, oExp := o.decompose()
+
+	switch {
+	case dExp < oExp:
+		return -1
+	case dExp > oExp:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// totalRank returns the rank of the kind of d in the total order of absolute
+// values: finite values, then infinities, then signaling and quiet NaNs.
+func (d Decimal) totalRank() int {
+	switch {
+	case d.isSignaling():
+		return 2
+	case d.IsNaN():
+		return 3
+	case d.isInf():
+		return 1
+	default:
+		return 0
+	}
+}
+
 // CmpResult represents the result from comparing two Decimals. When the values
 // being compared aren't NaNs, the integer value of the CmpResult will be:
 //
@@ -1035,6 +1243,42 @@ func (d Decimal) Equal(o Decimal) bool {
 	return dSig == oSig
 }
 
+// EqualWithin reports whether d and o are equal within the given absolute or
+// relative tolerance, that is whether
+//
+//	|d - o| <= max(absTol, relTol × max(|d|, |o|))
+//
+// The difference is rounded away from zero and the relative tolerance towards
+// zero, so rounding never causes values to be reported as equal. Infinities
+// are only within tolerance of an infinity with the same sign, and NaN values
+// are never within tolerance of any value.
+func (d Decimal) EqualWithin(o, absTol, relTol Decimal) bool {
+	if d.IsNaN() || o.IsNaN() || absTol.IsNaN() || relTol.IsNaN() {
+		return false
+	}
+
+	if d.Equal(o) {
+		return true
+	}
+
+	if d.isInf() || o.isInf() {
+		return false
+	}
+
+	diff := Abs(d.SubWithMode(o, AwayFromZero))
+
+	if diff.isInf() {
+		return ScaleB(d, -1).EqualWithin(ScaleB(o, -1), ScaleB(absTol, -1), relTol)
+	}
+
+	if !diff.Cmp(absTol).Greater() {
+		return true
+	}
+
+	tol := relTol.MulWithMode(Max(Abs(d), Abs(o)), ToZero)
+	return !diff.Cmp(tol).Greater()
+}
+
 // IsZero reports whether the Decimal is equal to zero. This method will return
 // true for both positive and negative zero.
 func (d Decimal) IsZero() bool {
@@ -1049,6 +1293,71 @@ func (d Decimal) IsZero() bool {
 	}
 }
 
+// IsInteger reports whether d is a finite value with no non-zero digits after
+// the decimal point.
+func (d Decimal) IsInteger() bool {
+	_, ok := d.parity()
+	return ok
+}
+
+// IsEven reports whether d is an integer that is divisible by 2.
+func (d Decimal) IsEven() bool {
+	odd, ok := d.parity()
+	return ok && !odd
+}
+
+// IsOdd reports whether d is an integer that is not divisible by 2.
+func (d Decimal) IsOdd() bool {
+	odd, ok := d.parity()
+	return ok && odd
+}
+
+// parity reports whether d is an integer, and if so whether it is odd.
+func (d Decimal) parity() (odd, ok bool) {
+	if d.isSpecial() {
+		return false, false
+	}
+
+	sig, exp := d.decompose()
+
+	if exp > exponentBias {
+		return false, true
+	}
+
+	if exponentBias-int(exp) > maxDigits {
+		return false, sig == (uint128{})
+	}
+
+	for ; exp < exponentBias; exp++ {
+		var rem uint64
+		sig, rem = sig.div10()
+
+		if rem != 0 {
+			return false, false
+		}
+	}
+
+	return sig[0]&1 == 1, true
+}
+
+// SameQuantum reports whether d and o have the same exponent. Two NaN values
+// or two infinities always have the same quantum, while a special value never
+// has the same quantum as a finite value.
+func (d Decimal) SameQuantum(o Decimal) bool {
+	if d.isSpecial() || o.isSpecial() {
+		if d.IsNaN() || o.IsNaN() {
+			return d.IsNaN() && o.IsNaN()
+		}
+
+		return d.isInf() && o.isInf()
+	}
+
+	_, dExp := d.decompose()
+	_, oExp := o.decompose()
+
+	return dExp == oExp
+}
+
 func (d Decimal) isOne() bool {
 	if d.isSpecial() {
 		return false
@@ -1056,7 +1365,7 @@ func (d Decimal) isOne() bool {
 
 	sig, exp := d.decompose()
 
-	if exp < int16(-len(uint128PowersOf10)+exponentBias) || exp > exponentBias {
+	if exp <= int16(-len(uint128PowersOf10)+exponentBias) || exp > exponentBias {
 		return false
 	}
 
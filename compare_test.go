@@ -176,6 +176,106 @@ func TestDecimalIsZero(t *testing.T) {
 	}
 }
 
+func TestDecimalEqualWithin(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		lhs, rhs       string
+		absTol, relTol string
+		want           bool
+	}{
+		{"1", "1.00", "0", "0", true},
+		{"0", "-0", "0", "0", true},
+		{"1", "1.000000000000000000000000000000001", "0", "0", false},
+		{"1", "1.000000000000000000000000000000001", "1e-33", "0", true},
+		{"1", "1.000000000000000000000000000000001", "0", "1e-33", true},
+		{"1", "1.000000000000000000000000000000001", "0", "1e-34", false},
+		{"1", "1.0000000000000000000000000000000005", "0", "0", false},
+		{"1", "1.0000000000000000000000000000000005", "5e-34", "0", true},
+		{"100", "101", "1", "0", true},
+		{"100", "101", "0.999", "0", false},
+		{"100", "101", "0", "0.01", true},
+		{"100", "101", "0", "0.0099", false},
+		{"-100", "-101", "0", "0.01", true},
+		{"-1", "1", "2", "0", true},
+		{"-1", "1", "1.999", "1", false},
+		{"9e6144", "-9e6144", "0", "2", true},
+		{"9e6144", "-9e6144", "0", "1.999", false},
+		{"9e6144", "-9e6144", "Inf", "0", true},
+		{"Inf", "Inf", "0", "0", true},
+		{"-Inf", "Inf", "Inf", "Inf", false},
+		{"Inf", "9e6144", "Inf", "Inf", false},
+		{"NaN", "NaN", "Inf", "Inf", false},
+		{"1", "NaN", "Inf", "Inf", false},
+		{"1", "1", "NaN", "0", false},
+		{"1", "2", "-1", "-1", false},
+	}
+
+	for _, test := range tests {
+		lhs := MustParse(test.lhs)
+		rhs := MustParse(test.rhs)
+		absTol := MustParse(test.absTol)
+		relTol := MustParse(test.relTol)
+
+		if res := lhs.EqualWithin(rhs, absTol, relTol); res != test.want {
+			t.Errorf("%v.EqualWithin(%v, %v, %v) = %t, want %t", lhs, rhs, absTol, relTol, res, test.want)
+		}
+
+		if res := rhs.EqualWithin(lhs, absTol, relTol); res != test.want {
+			t.Errorf("%v.EqualWithin(%v, %v, %v) = %t, want %t", rhs, lhs, absTol, relTol, res, test.want)
+		}
+	}
+}
+
+func TestDecimalIsInteger(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		val     string
+		integer bool
+		even    bool
+		odd     bool
+	}{
+		{"0", true, true, false},
+		{"-0.000", true, true, false},
+		{"0e100", true, true, false},
+		{"1", true, false, true},
+		{"-1", true, false, true},
+		{"2", true, true, false},
+		{"1.0", true, false, true},
+		{"3.000", true, false, true},
+		{"4.000", true, true, false},
+		{"1.5", false, false, false},
+		{"-2.01", false, false, false},
+		{"1e1", true, true, false},
+		{"15e-1", false, false, false},
+		{"150e-2", false, false, false},
+		{"1234567890123456789012345678901233", true, false, true},
+		{"12345678901234567890123456789012330000e-4", true, false, true},
+		{"1e-6176", false, false, false},
+		{"9.999999999999999999999999999999999e6144", true, true, false},
+		{"Inf", false, false, false},
+		{"-Inf", false, false, false},
+		{"NaN", false, false, false},
+	}
+
+	for _, test := range tests {
+		val := MustParse(test.val)
+
+		if res := val.IsInteger(); res != test.integer {
+			t.Errorf("%v.IsInteger() = %t, want %t", val, res, test.integer)
+		}
+
+		if res := val.IsEven(); res != test.even {
+			t.Errorf("%v.IsEven() = %t, want %t", val, res, test.even)
+		}
+
+		if res := val.IsOdd(); res != test.odd {
+			t.Errorf("%v.IsOdd() = %t, want %t", val, res, test.odd)
+		}
+	}
+}
+
 func TestMax(t *testing.T) {
 	t.Parallel()
 
@@ -193,6 +293,11 @@ func TestMax(t *testing.T) {
 			t.Errorf("Max(%v, %v) = %v, want %v", lhs, rhs, max, res)
 		}
 	}
+
+	testMinMax(t, "Maximum", "maximum", Maximum)
+	testMinMax(t, "MaxNum", "maxnum", MaxNum)
+	testMinMax(t, "MaxMag", "maxmag", MaxMag)
+	testMinMax(t, "MaxMagNum", "maxmagnum", MaxMagNum)
 }
 
 func TestMin(t *testing.T) {
@@ -212,6 +317,32 @@ func TestMin(t *testing.T) {
 			t.Errorf("Min(%v, %v) = %v, want %v", lhs, rhs, min, res)
 		}
 	}
+
+	testMinMax(t, "Minimum", "minimum", Minimum)
+	testMinMax(t, "MinNum", "minnum", MinNum)
+	testMinMax(t, "MinMag", "minmag", MinMag)
+	testMinMax(t, "MinMagNum", "minmagnum", MinMagNum)
+}
+
+func testMinMax(t *testing.T, name, op string, f func(Decimal, Decimal) Decimal) {
+	t.Run(name, func(t *testing.T) {
+		t.Parallel()
+
+		r := openTestData(t)
+		defer r.close()
+
+		var lhs Decimal
+		var rhs Decimal
+		var res Decimal
+
+		for r.scan(op+"(%v, %v) = %v\n", &lhs, &rhs, &res) {
+			got := f(lhs, rhs)
+
+			if !resultEqual(got, res) || res.IsNaN() && (got.IsSignalingNaN() || got.String() != res.String()) {
+				t.Errorf("%s(%v, %v) = %v, want %v", name, lhs, rhs, got, res)
+			}
+		}
+	})
 }
 
 func BenchmarkDecimalCmp(b *testing.B) {
@@ -267,3 +398,37 @@ func BenchmarkDecimalEqual(b *testing.B) {
 		}
 	}
 }
+
+func TestTotalOrder(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var lhs Decimal
+	var rhs Decimal
+	var res testCmpResult
+
+	for r.scan("%v totalorder %v = %v\n", &lhs, &rhs, &res) {
+		if cmp := TotalOrder(lhs, rhs); cmp != int(res) {
+			t.Errorf("TotalOrder(%v, %v) = %d, want %d", lhs, rhs, cmp, res)
+		}
+	}
+}
+
+func TestTotalOrderMag(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var lhs Decimal
+	var rhs Decimal
+	var res testCmpResult
+
+	for r.scan("%v totalorder %v = %v\n", &lhs, &rhs, &res) {
+		if cmp := TotalOrderMag(lhs, rhs); cmp != int(res) {
+			t.Errorf("TotalOrderMag(%v, %v) = %d, want %d", lhs, rhs, cmp, res)
+		}
+	}
+}
@@ -0,0 +1,440 @@
+package decimal128
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	maxPrecision        = 34
+	maxAdjustedExponent = maxUnbiasedExponent + maxPrecision - 1
+	minAdjustedExponent = 1 - maxAdjustedExponent
+)
+
+// Condition is a set of exceptional conditions that can be signalled by an
+// operation performed through a [Context]. Conditions are bit flags and can
+// be combined using bitwise operators.
+type Condition uint16
+
+const (
+	Inexact          Condition = 1 << iota // result was rounded and is not exact
+	Rounded                                // result was rounded, possibly exactly
+	Overflow                               // result was too large to represent
+	Underflow                              // result was tiny and inexact
+	Subnormal                              // result was tiny
+	Clamped                                // result exponent was altered to fit
+	DivisionByZero                         // finite value divided by zero
+	InvalidOperation                       // result is NaN from non-NaN or sNaN operands
+)
+
+var conditionNames = [...]string{
+	"Inexact",
+	"Rounded",
+	"Overflow",
+	"Underflow",
+	"Subnormal",
+	"Clamped",
+	"DivisionByZero",
+	"InvalidOperation",
+}
+
+// String returns a string representation of the set of conditions, with the
+// name of each condition separated by a '|'.
+func (c Condition) String() string {
+	if c == 0 {
+		return "Condition(0)"
+	}
+
+	var names []string
+	for i, name := range conditionNames {
+		if c&(1<<i) != 0 {
+			names = append(names, name)
+			c &^= 1 << i
+		}
+	}
+
+	if c != 0 {
+		names = append(names, fmt.Sprintf("Condition(%d)", uint16(c)))
+	}
+
+	return strings.Join(names, "|")
+}
+
+// Context controls how arithmetic operations performed through it are
+// rounded, and records the exceptional conditions they signal. The zero value
+// is ready to use and rounds using [ToNearestEven] to the full precision and
+// exponent range of a Decimal.
+//
+// A Context is not safe for concurrent use by multiple goroutines, as every
+// operation updates Flags.
+type Context struct {
+	// Mode is the rounding mode used by operations.
+	Mode RoundingMode
+
+	// Source is the source of the random numbers drawn when Mode is
+	// [Stochastic]. If it is nil, the top-level functions of math/rand/v2 are
+	// used.
+	Source RandomSource
+
+	// Precision is the maximum number of significant digits in a result. It
+	// must be between 1 and 34 to reduce the precision of results. Any other
+	// value uses the full precision of a Decimal.
+	Precision int
+
+	// MaxExponent and MinExponent are the largest and smallest adjusted
+	// exponents (the exponent of the most significant digit) a normal result
+	// can have. Results with a larger adjusted exponent overflow, results
+	// with a smaller one are subnormal. Zero, or any value outside of the
+	// range of a Decimal, uses the limits of a Decimal (6144 and -6143).
+	MaxExponent int
+	MinExponent int
+
+	// Flags holds every condition signalled since it was last cleared. Flags
+	// are sticky: operations only ever add to them.
+	Flags Condition
+}
+
+// Add adds x and y and returns the result.
+func (c *Context) Add(x, y Decimal) Decimal {
+	if x.isSpecial() || y.isSpecial() {
+		return c.special(x.AddWithMode(y, c.Mode), x, y)
+	}
+
+	return c.apply(func(mode rounding) (Decimal, bool) {
+		return x.add(y, mode, false)
+	}, x, y)
+}
+
+// Exp returns e**d, the base-e exponential of d.
+func (c *Context) Exp(d Decimal) Decimal {
+	return c.apply(d.exp, d)
+}
+
+// Log returns the natural logarithm of d.
+func (c *Context) Log(d Decimal) Decimal {
+	if d.IsZero() {
+		c.Flags |= DivisionByZero
+		return inf(true)
+	}
+
+	return c.apply(d.log, d)
+}
+
+// Mul multiplies x and y and returns the result.
+func (c *Context) Mul(x, y Decimal) Decimal {
+	return c.apply(func(mode rounding) (Decimal, bool) {
+		return x.mul(y, mode)
+	}, x, y)
+}
+
+// Pow raises x to the power of y and returns the result.
+func (c *Context) Pow(x, y Decimal) Decimal {
+	if x.IsZero() && !y.isSpecial() && y.Signbit() && !y.IsZero() {
+		c.Flags |= DivisionByZero
+		return x.PowWithMode(y, c.Mode)
+	}
+
+	return c.apply(func(mode rounding) (Decimal, bool) {
+		return x.pow(y, mode)
+	}, x, y)
+}
+
+// Quo divides x by y and returns the result.
+func (c *Context) Quo(x, y Decimal) Decimal {
+	if !x.isSpecial() && !x.IsZero() && y.IsZero() {
+		c.Flags |= DivisionByZero
+		return x.QuoWithMode(y, c.Mode)
+	}
+
+	return c.apply(func(mode rounding) (Decimal, bool) {
+		return x.quo(y, mode)
+	}, x, y)
+}
+
+// QuoRem divides x by y and returns the result as an integer quotient and a
+// remainder. If the integer quotient cannot be represented exactly using the
+// precision of c, InvalidOperation is signalled and both results are NaN.
+func (c *Context) QuoRem(x, y Decimal) (Decimal, Decimal) {
+	if x.isSpecial() || y.isSpecial() || y.IsZero() {
+		quo, rem := x.QuoRemWithMode(y, c.Mode)
+
+		if !x.isSpecial() && !x.IsZero() && y.IsZero() {
+			c.Flags |= DivisionByZero | InvalidOperation
+			return quo, rem
+		}
+
+		return c.special(quo, x, y), c.special(rem, x, y)
+	}
+
+	quo, rem, inexact := x.quoRem(y, rounding{c.Mode, c.Source})
+	prec, _, _, native := c.limits()
+
+	if !inexact && !native && !quo.IsZero() {
+		sig, exp := quo.decompose()
+		inexact = sig.log10()+1+int(exp)-exponentBias > prec
+	}
+
+	if inexact {
+		c.Flags |= InvalidOperation
+
+		lhs := payloadValPosFinite
+		if x.Signbit() {
+			lhs = payloadValNegFinite
+		}
+
+		rhs := payloadValPosFinite
+		if y.Signbit() {
+			rhs = payloadValNegFinite
+		}
+
+		res := nan(payloadOpQuoRem, lhs, rhs)
+		return res, res
+	}
+
+	return quo, c.apply(func(rounding) (Decimal, bool) {
+		return rem, false
+	}, x, y)
+}
+
+// Sqrt returns the square root of d.
+func (c *Context) Sqrt(d Decimal) Decimal {
+	return c.apply(d.sqrt, d)
+}
+
+// Sub subtracts y from x and returns the result.
+func (c *Context) Sub(x, y Decimal) Decimal {
+	if x.isSpecial() || y.isSpecial() {
+		return c.special(x.SubWithMode(y, c.Mode), x, y)
+	}
+
+	return c.apply(func(mode rounding) (Decimal, bool) {
+		return x.add(y, mode, true)
+	}, x, y)
+}
+
+// limits returns the precision and adjusted exponent limits of c, and
+// whether they are the native limits of a Decimal.
+func (c *Context) limits() (prec, emax, emin int, native bool) {
+	prec, emax, emin = c.Precision, c.MaxExponent, c.MinExponent
+	native = true
+
+	if prec < 1 || prec > maxPrecision {
+		prec = maxPrecision
+	} else {
+		native = false
+	}
+
+	if emax <= 0 || emax > maxAdjustedExponent {
+		emax = maxAdjustedExponent
+	} else {
+		native = false
+	}
+
+	if emin >= 0 || emin < minAdjustedExponent {
+		emin = minAdjustedExponent
+	} else {
+		native = false
+	}
+
+	return prec, emax, emin, native
+}
+
+// apply performs op, which reports whether its result is inexact, and rounds
+// the result to the precision and exponent range of c, updating Flags.
+//
+// When the result needs to be rounded again to fit into c the operation is
+// performed using ToZero for anything but the directed rounding modes, as
+// rounding twice to nearest could otherwise move the result by more than half
+// a unit. Directed rounding modes and ToZero05Up can be safely applied twice.
+func (c *Context) apply(op func(rounding) (Decimal, bool), operands ...Decimal) Decimal {
+	prec, emax, emin, native := c.limits()
+
+	rm := rounding{c.Mode, c.Source}
+
+	mode := rm
+	if !native {
+		switch mode.RoundingMode {
+		case ToZero, AwayFromZero, ToNegativeInf, ToPositiveInf, ToZero05Up:
+		default:
+			mode = rounding{RoundingMode: ToZero}
+		}
+	}
+
+	res, inexact := op(mode)
+
+	if res.isSpecial() {
+		return c.special(res, operands...)
+	}
+
+	for _, v := range operands {
+		if v.isSpecial() {
+			return res
+		}
+	}
+
+	neg := res.Signbit()
+	sig, exp := res.decompose()
+	e := int(exp) - exponentBias
+
+	etiny := emin - (prec - 1)
+	etop := emax - (prec - 1)
+	if etop > maxUnbiasedExponent {
+		etop = maxUnbiasedExponent
+	}
+
+	drop := func() int {
+		if sig == (uint128{}) || native {
+			return 0
+		}
+
+		n := sig.log10() + 1 - prec
+		if e+n < etiny {
+			n = etiny - e
+		}
+
+		return n
+	}
+
+	sticky := mode.RoundingMode != c.Mode
+
+	if sticky && inexact && drop() <= 0 {
+		res, inexact = op(rm)
+		sig, exp = res.decompose()
+		e = int(exp) - exponentBias
+		sticky = false
+	}
+
+	if n := drop(); n > 0 {
+		var trunc int8
+		var digit, frac uint64
+
+		if sticky && inexact {
+			trunc = 1
+		}
+
+		if n > sig.log10()+1 {
+			frac = rm.digitsFrac(sig, n)
+			sig = uint128{}
+			trunc = 1
+		} else {
+			for i := 0; i < n; i++ {
+				if digit != 0 {
+					trunc = 1
+				}
+
+				sig, digit = sig.div10()
+				frac = rm.shiftFrac(frac, trunc, digit, 10)
+			}
+		}
+
+		if trunc != 0 || digit != 0 {
+			inexact = true
+		}
+
+		sig, _, _ = rm.round(false, neg, sig, 0, trunc, digit, frac)
+		e += n
+
+		if sig == uint128PowersOf10[prec] {
+			sig = uint128PowersOf10[prec-1]
+			e++
+		}
+
+		c.Flags |= Rounded
+	}
+
+	if inexact {
+		c.Flags |= Inexact | Rounded
+	}
+
+	if sig == (uint128{}) {
+		if inexact {
+			c.Flags |= Underflow | Subnormal
+		}
+
+		if e < etiny {
+			e = etiny
+		} else if e > etop {
+			e = etop
+		}
+
+		return compose(neg, sig, int16(e+exponentBias))
+	}
+
+	adj := e + sig.log10()
+
+	if !native && adj > emax {
+		return c.overflow(neg)
+	}
+
+	if adj < emin {
+		c.Flags |= Subnormal
+
+		if inexact {
+			c.Flags |= Underflow
+		}
+	}
+
+	if e > etop {
+		for e > etop {
+			sig = sig.mul64(10)
+			e--
+		}
+
+		c.Flags |= Clamped
+	}
+
+	return compose(neg, sig, int16(e+exponentBias))
+}
+
+// overflow signals an overflow and returns either the largest finite value
+// of c or infinity, depending on the rounding mode.
+func (c *Context) overflow(neg bool) Decimal {
+	c.Flags |= Overflow | Inexact | Rounded
+
+	switch c.Mode {
+	case ToZero, ToZero05Up:
+	case ToNegativeInf:
+		if neg {
+			return inf(neg)
+		}
+	case ToPositiveInf:
+		if !neg {
+			return inf(neg)
+		}
+	default:
+		return inf(neg)
+	}
+
+	prec, emax, _, _ := c.limits()
+
+	sig := uint128PowersOf10[prec].sub64(1)
+	e := emax - (prec - 1)
+
+	for e > maxUnbiasedExponent {
+		sig = sig.mul64(10)
+		e--
+	}
+
+	return compose(neg, sig, int16(e+exponentBias))
+}
+
+// special updates Flags for a result that is computed from special operands
+// or is special itself, and returns it.
+func (c *Context) special(res Decimal, operands ...Decimal) Decimal {
+	var hasNaN, hasSNaN, hasInf bool
+	for _, v := range operands {
+		hasNaN = hasNaN || v.IsNaN()
+		hasSNaN = hasSNaN || v.isSignaling()
+		hasInf = hasInf || v.isInf()
+	}
+
+	if res.IsNaN() {
+		if !hasNaN || hasSNaN {
+			c.Flags |= InvalidOperation
+		}
+	} else if res.isInf() && !hasInf {
+		return c.overflow(res.Signbit())
+	}
+
+	return res
+}
@@ -0,0 +1,135 @@
+package decimal128
+
+import "testing"
+
+func TestContext(t *testing.T) {
+	t.Parallel()
+
+	pos := MustParse("9.999999999999999999999999999999999E+6144")
+
+	tests := []struct {
+		ctx   Context
+		op    string
+		lhs   string
+		rhs   string
+		res   Decimal
+		flags Condition
+	}{
+		{Context{}, "+", "1", "2", MustParse("3"), 0},
+		{Context{}, "/", "1", "3", MustParse("0.3333333333333333333333333333333333"), Inexact | Rounded},
+		{Context{}, "/", "1", "0", Inf(1), DivisionByZero},
+		{Context{}, "/", "0", "0", NaN(), InvalidOperation},
+		{Context{}, "/", "NaN", "0", NaN(), 0},
+		{Context{}, "+", "sNaN", "1", NaN(), InvalidOperation},
+		{Context{}, "*", "NaN", "sNaN1", NaN(), InvalidOperation},
+		{Context{}, "sqrt", "sNaN", "", NaN(), InvalidOperation},
+		{Context{}, "*", "9E+6144", "10", Inf(1), Overflow | Inexact | Rounded},
+		{Context{Mode: ToZero}, "*", "9E+6144", "10", pos, Overflow | Inexact | Rounded},
+		{Context{Mode: ToNegativeInf}, "*", "-9E+6144", "10", Inf(-1), Overflow | Inexact | Rounded},
+		{Context{Mode: ToNegativeInf}, "*", "9E+6144", "10", pos, Overflow | Inexact | Rounded},
+		{Context{}, "*", "Inf", "10", Inf(1), 0},
+		{Context{}, "-", "Inf", "Inf", NaN(), InvalidOperation},
+		{Context{Precision: 5}, "/", "1", "3", MustParse("0.33333"), Inexact | Rounded},
+		{Context{Precision: 3}, "+", "1.234", "0", MustParse("1.23"), Inexact | Rounded},
+		{Context{Precision: 3}, "+", "1.2", "0", MustParse("1.2"), 0},
+		{Context{Precision: 3}, "+", "1.200", "0", MustParse("1.20"), Rounded},
+		{Context{Precision: 3, Mode: ToPositiveInf}, "+", "1.234", "0", MustParse("1.24"), Inexact | Rounded},
+		{Context{Precision: 3, Mode: ToNegativeInf}, "-", "-1.234", "0", MustParse("-1.24"), Inexact | Rounded},
+		{Context{Precision: 3, Mode: ToNegativeInf}, "-", "1", "1", MustParse("-0"), 0},
+		{Context{Precision: 4}, "+", "1.235", "0.000499999999999999999999999999999999", MustParse("1.235"), Inexact | Rounded},
+		{Context{Precision: 4, Mode: ToNearestAway}, "+", "1.235", "0.000499999999999999999999999999999999", MustParse("1.235"), Inexact | Rounded},
+		{Context{Precision: 3}, "*", "999", "1.001", MustParse("1.00E+3"), Inexact | Rounded},
+		{Context{MaxExponent: 2}, "*", "100", "10", Inf(1), Overflow | Inexact | Rounded},
+		{Context{Precision: 3, MaxExponent: 2, Mode: ToZero}, "*", "100", "10", MustParse("999"), Overflow | Inexact | Rounded},
+		{Context{Precision: 3, MaxExponent: 5}, "*", "1", "1E+5", MustParse("1.00E+5"), Clamped},
+		{Context{Precision: 3, MinExponent: -2}, "/", "1", "1000", MustParse("0.001"), Rounded | Subnormal},
+		{Context{Precision: 3, MinExponent: -2}, "/", "1", "3000", MustParse("0.0003"), Subnormal | Underflow | Inexact | Rounded},
+		{Context{Precision: 3, MinExponent: -2}, "/", "1", "30000", MustParse("0.0000"), Subnormal | Underflow | Inexact | Rounded},
+		{Context{}, "sqrt", "-1", "", NaN(), InvalidOperation},
+		{Context{Precision: 5}, "sqrt", "2", "", MustParse("1.4142"), Inexact | Rounded},
+		{Context{}, "log", "0", "", Inf(-1), DivisionByZero},
+		{Context{}, "exp", "20000", "", Inf(1), Overflow | Inexact | Rounded},
+		{Context{}, "exp", "-Inf", "", MustParse("0"), 0},
+		{Context{}, "pow", "0", "-1", Inf(1), DivisionByZero},
+		{Context{}, "pow", "2", "100000", Inf(1), Overflow | Inexact | Rounded},
+	}
+
+	for _, test := range tests {
+		ctx := test.ctx
+		lhs := MustParse(test.lhs)
+
+		var res Decimal
+		switch test.op {
+		case "+":
+			res = ctx.Add(lhs, MustParse(test.rhs))
+		case "-":
+			res = ctx.Sub(lhs, MustParse(test.rhs))
+		case "*":
+			res = ctx.Mul(lhs, MustParse(test.rhs))
+		case "/":
+			res = ctx.Quo(lhs, MustParse(test.rhs))
+		case "pow":
+			res = ctx.Pow(lhs, MustParse(test.rhs))
+		case "sqrt":
+			res = ctx.Sqrt(lhs)
+		case "exp":
+			res = ctx.Exp(lhs)
+		case "log":
+			res = ctx.Log(lhs)
+		}
+
+		if !resultEqual(res, test.res) {
+			t.Errorf("%+v %s(%s, %s) = %v, want %v", test.ctx, test.op, test.lhs, test.rhs, res, test.res)
+		}
+
+		if ctx.Flags != test.flags {
+			t.Errorf("%+v %s(%s, %s) flags = %v, want %v", test.ctx, test.op, test.lhs, test.rhs, ctx.Flags, test.flags)
+		}
+	}
+}
+
+func TestContextQuoRem(t *testing.T) {
+	t.Parallel()
+
+	ctx := Context{Precision: 3}
+
+	quo, rem := ctx.QuoRem(MustParse("7"), MustParse("2"))
+
+	if !quo.Equal(MustParse("3")) || !rem.Equal(MustParse("1")) || ctx.Flags != 0 {
+		t.Errorf("QuoRem(7, 2) = (%v, %v), %v, want (3, 1), %v", quo, rem, ctx.Flags, Condition(0))
+	}
+
+	quo, rem = ctx.QuoRem(MustParse("12345"), MustParse("1"))
+
+	if !quo.IsNaN() || !rem.IsNaN() || ctx.Flags != InvalidOperation {
+		t.Errorf("QuoRem(12345, 1) = (%v, %v), %v, want (NaN, NaN), %v", quo, rem, ctx.Flags, InvalidOperation)
+	}
+
+	ctx.Flags = 0
+	ctx.Quo(MustParse("1"), MustParse("3"))
+	ctx.Add(MustParse("1"), MustParse("2"))
+
+	if ctx.Flags != Inexact|Rounded {
+		t.Errorf("sticky flags = %v, want %v", ctx.Flags, Inexact|Rounded)
+	}
+}
+
+func TestConditionString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		cond Condition
+		want string
+	}{
+		{0, "Condition(0)"},
+		{Inexact, "Inexact"},
+		{Inexact | Rounded, "Inexact|Rounded"},
+		{Overflow | InvalidOperation | 1<<12, "Overflow|InvalidOperation|Condition(4096)"},
+	}
+
+	for _, test := range tests {
+		if res := test.cond.String(); res != test.want {
+			t.Errorf("Condition(%d).String() = %s, want %s", uint16(test.cond), res, test.want)
+		}
+	}
+}
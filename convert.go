@@ -31,6 +31,10 @@ func FromFloat32(f float32) Decimal {
 
 // FromFloat64 converts f into a Decimal.
 func FromFloat64(f float64) Decimal {
+	return fromFloat64(f, DefaultRounder().rounding())
+}
+
+func fromFloat64(f float64, mode rounding) Decimal {
 	if math.IsNaN(f) {
 		return nan(payloadOpFromFloat64, 0, 0)
 	}
@@ -145,7 +149,7 @@ func FromFloat64(f float64) Decimal {
 		}
 	}
 
-	sig, exp := DefaultRoundingMode.reduce256(neg, sig256, exp, trunc)
+	sig, exp, _ := mode.reduce256(neg, sig256, exp, trunc, 0)
 
 	if exp > maxBiasedExponent {
 		return inf(neg)
@@ -215,7 +219,7 @@ func FromInt(i *big.Int) Decimal {
 		sig = sig.or64(uint64(b[i]))
 	}
 
-	sig, exp = DefaultRoundingMode.reduce128(neg, sig, exp, trunc)
+	sig, exp, _ = DefaultRounder().rounding().reduce128(neg, sig, exp, trunc, 0)
 
 	if exp > maxBiasedExponent {
 		return inf(neg)
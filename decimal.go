@@ -1,8 +1,14 @@
 // Package decimal128 provides a 128-bit decimal floating point type.
+//
+// The results of [Exp], [Exp10], [Exp2], [Log], [Log10], [Log2], [Sqrt],
+// [Sin], [Cos], [Tan] and [Decimal.Pow] are correctly rounded in every
+// rounding mode: each is the exact result rounded once, as if computed with
+// unlimited precision.
 package decimal128
 
 import (
 	"math"
+	"math/big"
 	"strconv"
 )
 
@@ -42,12 +48,31 @@ func Inf(sign int) Decimal {
 }
 
 // NaN returns a new Decimal set to the "not-a-number" value.
+//
+// The payload of every NaN generated by this package has bit 109 set, to tell
+// it apart from a payload parsed from text such as "NaN123", which must be
+// less than 2**109. This changed the binary encoding of generated NaNs: NaN
+// values encoded by earlier versions of this package decode with their
+// diagnostic payload as a number, and are formatted as, for example, "NaN5"
+// instead of "NaN".
 func NaN() Decimal {
 	return nan(payloadOpNaN, 0, 0)
 }
 
+// SNaN returns a new Decimal set to a signaling "not-a-number" value with the
+// provided payload. A signaling NaN passed to an arithmetic operation is
+// converted into a quiet NaN with the same payload, and causes a [Context] to
+// signal InvalidOperation.
+func SNaN(payload uint64) Decimal {
+	return Decimal{payload, 0x7e00_0000_0000_0000}
+}
+
 // New returns a new Decimal with the provided significand and exponent.
 func New(sig int64, exp int) Decimal {
+	return newDecimal(sig, exp, DefaultRounder().rounding())
+}
+
+func newDecimal(sig int64, exp int, mode rounding) Decimal {
 	if sig == 0 {
 		return zero(false)
 	}
@@ -66,7 +91,7 @@ func New(sig int64, exp int) Decimal {
 		return inf(neg)
 	}
 
-	sig128, exp16 := DefaultRoundingMode.reduce64(neg, uint64(sig), int16(exp+exponentBias))
+	sig128, exp16, _ := mode.reduce64(neg, uint64(sig), int16(exp+exponentBias))
 
 	if exp > maxBiasedExponent {
 		return inf(neg)
@@ -75,6 +100,29 @@ func New(sig int64, exp int) Decimal {
 	return compose(neg, sig128, exp16)
 }
 
+// NewFromCoefficient returns a new Decimal with the provided sign, coefficient
+// and exponent, where the coefficient is the unsigned 128-bit integer formed
+// from hi and lo. The Decimal is constructed exactly and is always canonical:
+// an error is returned if the coefficient is greater than 10**34-1 or if exp
+// is outside of the exponent range of a Decimal, [-6176, 6111]. The error can
+// be compared to [strconv.ErrRange] via [errors.Is].
+//
+// The coefficient of a Decimal produced by arithmetic can have 35 digits, see
+// [Decimal.IsCanonical], in which case it is rejected by NewFromCoefficient.
+func NewFromCoefficient(neg bool, hi, lo uint64, exp int) (Decimal, error) {
+	sig := uint128{lo, hi}
+
+	if sig.cmp(uint128PowersOf10[maxPrecision]) >= 0 {
+		return Decimal{}, &coefficientRangeError{hi, lo}
+	}
+
+	if exp < minUnbiasedExponent || exp > maxUnbiasedExponent {
+		return Decimal{}, &exponentRangeError{exp}
+	}
+
+	return compose(neg, sig, int16(exp+exponentBias)), nil
+}
+
 func compose(neg bool, sig uint128, exp int16) Decimal {
 	var hi uint64
 	if sig[1] > 0x0001_ffff_ffff_ffff {
@@ -99,7 +147,26 @@ func inf(neg bool) Decimal {
 }
 
 func nan(op, lhs, rhs Payload) Decimal {
-	return Decimal{uint64(op | lhs<<8 | rhs<<16), 0x7c00_0000_0000_0000}
+	return Decimal{uint64(op | lhs<<8 | rhs<<16), 0x7c00_0000_0000_0000 | payloadDiagnostic}
+}
+
+// propagateNaN returns the result of an operation on operands, at least one of
+// which is NaN. The first signaling NaN takes precedence over quiet NaNs, and is
+// returned quieted.
+func propagateNaN(operands ...Decimal) Decimal {
+	for _, v := range operands {
+		if v.isSignaling() {
+			return v.quiet()
+		}
+	}
+
+	for _, v := range operands {
+		if v.IsNaN() {
+			return v
+		}
+	}
+
+	return nan(payloadOpNaN, 0, 0)
 }
 
 func one(neg bool) Decimal {
@@ -133,7 +200,7 @@ func zero(neg bool) Decimal {
 func (d Decimal) Canonical() Decimal {
 	if d.isSpecial() {
 		if d.IsNaN() {
-			return nan(0, 0, 0)
+			return Decimal{0, 0x7c00_0000_0000_0000}
 		}
 
 		return inf(d.Signbit())
@@ -166,6 +233,45 @@ func (d Decimal) Canonical() Decimal {
 	return compose(d.Signbit(), sig, exp)
 }
 
+// Coefficient returns the coefficient of d, the integer that is multiplied by
+// 10 raised to the power of [Decimal.Exponent] to give the absolute value of d.
+// If a non-nil argument i is provided, Coefficient stores the result in i
+// instead of allocating a new big.Int. The coefficient of ±Inf and NaN is 0.
+func (d Decimal) Coefficient(i *big.Int) *big.Int {
+	if i == nil {
+		i = new(big.Int)
+	}
+
+	hi, lo := d.CoefficientUint128()
+
+	i.SetUint64(hi)
+	i.Lsh(i, 64)
+
+	return i.Or(i, new(big.Int).SetUint64(lo))
+}
+
+// CoefficientUint128 returns the coefficient of d as the high and low 64 bits
+// of an unsigned 128-bit integer. The coefficient of ±Inf and NaN is 0.
+func (d Decimal) CoefficientUint128() (hi, lo uint64) {
+	if d.isSpecial() {
+		return 0, 0
+	}
+
+	sig, _ := d.decompose()
+	return sig[1], sig[0]
+}
+
+// Exponent returns the exponent of d, the power of 10 that its coefficient is
+// multiplied by. The exponent of ±Inf and NaN is 0.
+func (d Decimal) Exponent() int {
+	if d.isSpecial() {
+		return 0
+	}
+
+	_, exp := d.decompose()
+	return int(exp) - exponentBias
+}
+
 // IsInf reports whether d is an infinity. If sign > 0, IsInf reports whether
 // d is positive infinity. If sign < 0, IsInf reports whether d is negative
 // infinity. If sign == 0, IsInf reports whether d is either infinity.
@@ -185,16 +291,45 @@ func (d Decimal) IsInf(sign int) bool {
 	return d.Signbit()
 }
 
-// IsNaN reports whether d is a "not-a-number" value.
+// IsNaN reports whether d is a "not-a-number" value, either quiet or
+// signaling.
 func (d Decimal) IsNaN() bool {
 	return d.hi&0x7c00_0000_0000_0000 == 0x7c00_0000_0000_0000
 }
 
+// IsQuietNaN reports whether d is a quiet "not-a-number" value.
+func (d Decimal) IsQuietNaN() bool {
+	return d.hi&0x7e00_0000_0000_0000 == 0x7c00_0000_0000_0000
+}
+
+// IsSignalingNaN reports whether d is a signaling "not-a-number" value.
+func (d Decimal) IsSignalingNaN() bool {
+	return d.isSignaling()
+}
+
 // Neg returns d with its sign negated.
 func (d Decimal) Neg() Decimal {
 	return Decimal{d.lo, d.hi ^ 0x8000_0000_0000_0000}
 }
 
+// NumDigits returns the number of digits in the coefficient of d. A zero
+// coefficient has 1 digit, while ±Inf and NaN have 0 digits.
+func (d Decimal) NumDigits() int {
+	if d.isSpecial() {
+		return 0
+	}
+
+	sig, _ := d.decompose()
+	return sig.log10() + 1
+}
+
+// Scale returns the number of digits after the decimal point in the
+// representation of d, which is the negated [Decimal.Exponent]. The scale of
+// ±Inf and NaN is 0.
+func (d Decimal) Scale() int {
+	return -d.Exponent()
+}
+
 // Sign returns:
 //
 //	-1 if d <   0
@@ -260,10 +395,24 @@ func (d Decimal) isInf() bool {
 	return d.hi&0x7c00_0000_0000_0000 == 0x7800_0000_0000_0000
 }
 
+func (d Decimal) isSignaling() bool {
+	return d.hi&0x7e00_0000_0000_0000 == 0x7e00_0000_0000_0000
+}
+
 func (d Decimal) isSpecial() bool {
 	return d.hi&0x7800_0000_0000_0000 == 0x7800_0000_0000_0000
 }
 
+// quiet returns d with its signaling bit cleared if it is a signaling NaN,
+// keeping the payload, and d unchanged otherwise.
+func (d Decimal) quiet() Decimal {
+	if d.isSignaling() {
+		return Decimal{d.lo, d.hi &^ 0x0200_0000_0000_0000}
+	}
+
+	return d
+}
+
 func (d Decimal) Percentage() string {
 	if d.Equal(Zero) {
 		return "0"
@@ -292,3 +441,27 @@ func (d Decimal) FormatPercentage(prec int) string {
 		math.Trunc(d.Float64()/DefaultPow*pow*100.)/pow, 'f', prec, 64)
 	return result + "%"
 }
+
+type coefficientRangeError struct {
+	hi, lo uint64
+}
+
+func (err *coefficientRangeError) Error() string {
+	return "coefficient " + uint128{err.lo, err.hi}.String() + " out of range"
+}
+
+func (err *coefficientRangeError) Is(target error) bool {
+	return target == strconv.ErrRange
+}
+
+type exponentRangeError struct {
+	exp int
+}
+
+func (err *exponentRangeError) Error() string {
+	return "exponent " + strconv.Itoa(err.exp) + " out of range"
+}
+
+func (err *exponentRangeError) Is(target error) bool {
+	return target == strconv.ErrRange
+}
@@ -9,6 +9,7 @@ import (
 	"math"
 	"os"
 	"path/filepath"
+	"strconv"
 	"sync"
 	"testing"
 	"unsafe"
@@ -229,12 +230,15 @@ func (tr *testDataReader) scan(format string, args ...any) bool {
 }
 
 type testDataResult struct {
-	ToNearestEven Decimal
-	ToNearestAway Decimal
-	ToZero        Decimal
-	AwayFromZero  Decimal
-	ToNegativeInf Decimal
-	ToPositiveInf Decimal
+	ToNearestEven       Decimal
+	ToNearestAway       Decimal
+	ToZero              Decimal
+	AwayFromZero        Decimal
+	ToNegativeInf       Decimal
+	ToPositiveInf       Decimal
+	ToNearestTowardZero Decimal
+	ToNearestOdd        Decimal
+	ToZero05Up          Decimal
 }
 
 func (tr *testDataResult) Scan(f fmt.ScanState, verb rune) error {
@@ -267,6 +271,9 @@ func (tr *testDataResult) Scan(f fmt.ScanState, verb rune) error {
 	tr.AwayFromZero = res
 	tr.ToNegativeInf = res
 	tr.ToPositiveInf = res
+	tr.ToNearestTowardZero = res
+	tr.ToNearestOdd = res
+	tr.ToZero05Up = res
 
 	for index != -1 {
 		tok = tok[index+1:]
@@ -314,6 +321,12 @@ func (tr *testDataResult) Scan(f fmt.ScanState, verb rune) error {
 				tr.ToNegativeInf = res
 			case "PI":
 				tr.ToPositiveInf = res
+			case "NZ":
+				tr.ToNearestTowardZero = res
+			case "NO":
+				tr.ToNearestOdd = res
+			case "05":
+				tr.ToZero05Up = res
 			default:
 				return errors.New("invalid value \"" + string(mode) + "\"")
 			}
@@ -341,6 +354,12 @@ func (tr *testDataResult) result(mode RoundingMode) Decimal {
 		return tr.ToNegativeInf
 	case ToPositiveInf:
 		return tr.ToPositiveInf
+	case ToNearestTowardZero:
+		return tr.ToNearestTowardZero
+	case ToNearestOdd:
+		return tr.ToNearestOdd
+	case ToZero05Up:
+		return tr.ToZero05Up
 	default:
 		panic("invalid rounding mode " + mode.String())
 	}
@@ -392,6 +411,9 @@ func (tr *testDataResultPair) Scan(f fmt.ScanState, verb rune) error {
 	tr.first.AwayFromZero = firstRes
 	tr.first.ToNegativeInf = firstRes
 	tr.first.ToPositiveInf = firstRes
+	tr.first.ToNearestTowardZero = firstRes
+	tr.first.ToNearestOdd = firstRes
+	tr.first.ToZero05Up = firstRes
 
 	tr.second.ToNearestEven = secondRes
 	tr.second.ToNearestAway = secondRes
@@ -399,6 +421,9 @@ func (tr *testDataResultPair) Scan(f fmt.ScanState, verb rune) error {
 	tr.second.AwayFromZero = secondRes
 	tr.second.ToNegativeInf = secondRes
 	tr.second.ToPositiveInf = secondRes
+	tr.second.ToNearestTowardZero = secondRes
+	tr.second.ToNearestOdd = secondRes
+	tr.second.ToZero05Up = secondRes
 
 	for index != -1 {
 		tok = tok[index+1:]
@@ -461,6 +486,15 @@ func (tr *testDataResultPair) Scan(f fmt.ScanState, verb rune) error {
 			case "PI":
 				tr.first.ToPositiveInf = firstRes
 				tr.second.ToPositiveInf = secondRes
+			case "NZ":
+				tr.first.ToNearestTowardZero = firstRes
+				tr.second.ToNearestTowardZero = secondRes
+			case "NO":
+				tr.first.ToNearestOdd = firstRes
+				tr.second.ToNearestOdd = secondRes
+			case "05":
+				tr.first.ToZero05Up = firstRes
+				tr.second.ToZero05Up = secondRes
 			default:
 				return errors.New("invalid value \"" + string(mode) + "\"")
 			}
@@ -606,6 +640,51 @@ func TestCompose(t *testing.T) {
 	}
 }
 
+func TestDecimalCoefficient(t *testing.T) {
+	t.Parallel()
+
+	initDecimalValues()
+
+	for _, val := range decimalValues {
+		dec := val.Decimal()
+
+		if val.form != regularForm {
+			if hi, lo := dec.CoefficientUint128(); hi != 0 || lo != 0 || dec.Exponent() != 0 || dec.NumDigits() != 0 {
+				t.Errorf("%v coefficient = (%d, %d), exponent = %d, digits = %d, want all 0", val, hi, lo, dec.Exponent(), dec.NumDigits())
+			}
+
+			continue
+		}
+
+		hi, lo := dec.CoefficientUint128()
+
+		if hi != val.sig[1] || lo != val.sig[0] {
+			t.Errorf("%v.CoefficientUint128() = (%d, %d), want (%d, %d)", val, hi, lo, val.sig[1], val.sig[0])
+		}
+
+		if res := dec.Coefficient(nil); res.String() != val.sig.String() {
+			t.Errorf("%v.Coefficient() = %v, want %v", val, res, val.sig)
+		}
+
+		if res := dec.Exponent(); res != int(val.exp)-exponentBias || dec.Scale() != -res {
+			t.Errorf("%v.Exponent() = %d, Scale() = %d, want %d", val, res, dec.Scale(), int(val.exp)-exponentBias)
+		}
+
+		if res := dec.NumDigits(); res != len(val.sig.String()) {
+			t.Errorf("%v.NumDigits() = %d, want %d", val, res, len(val.sig.String()))
+		}
+
+		res, err := NewFromCoefficient(val.neg, hi, lo, dec.Exponent())
+		if !dec.IsCanonical() {
+			if !errors.Is(err, strconv.ErrRange) {
+				t.Errorf("NewFromCoefficient(%t, %d, %d, %d) = %v, %v, want strconv.ErrRange", val.neg, hi, lo, dec.Exponent(), res, err)
+			}
+		} else if err != nil || res != dec {
+			t.Errorf("NewFromCoefficient(%t, %d, %d, %d) = %v, %v, want %v, nil", val.neg, hi, lo, dec.Exponent(), res, err, dec)
+		}
+	}
+}
+
 func TestDecimalNeg(t *testing.T) {
 	t.Parallel()
 
@@ -683,6 +762,103 @@ func TestNaN(t *testing.T) {
 	}
 }
 
+func TestNewFromCoefficient(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		neg    bool
+		hi, lo uint64
+		exp    int
+		res    string
+		err    bool
+	}{
+		{false, 0, 12345, -2, "123.45", false},
+		{true, 0, 12345, 3, "-1.2345E+7", false},
+		{true, 0, 0, 0, "-0", false},
+		{false, 0x0001_ed09_bead_87c0, 0x378d_8e63_ffff_ffff, 6111, "9.999999999999999999999999999999999E+6144", false},
+		{false, 0, 1, -6176, "1E-6176", false},
+		{false, 0, 1, -6177, "", true},
+		{false, 0, 1, 6112, "", true},
+		{false, 0x0001_ed09_bead_87c0, 0x378d_8e64_0000_0000, 0, "", true},
+		{false, 0x0002_7fff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0, "", true},
+		{false, 0x0002_8000_0000_0000, 0, 0, "", true},
+		{false, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0, "", true},
+	}
+
+	for _, test := range tests {
+		res, err := NewFromCoefficient(test.neg, test.hi, test.lo, test.exp)
+
+		if test.err {
+			if !errors.Is(err, strconv.ErrRange) {
+				t.Errorf("NewFromCoefficient(%t, %d, %d, %d) = %v, %v, want strconv.ErrRange", test.neg, test.hi, test.lo, test.exp, res, err)
+			}
+
+			continue
+		}
+
+		if want := MustParse(test.res); err != nil || !resultEqual(res, want) || !res.SameQuantum(want) {
+			t.Errorf("NewFromCoefficient(%t, %d, %d, %d) = %v, %v, want %v, nil", test.neg, test.hi, test.lo, test.exp, res, err, want)
+		}
+	}
+}
+
+func TestSNaN(t *testing.T) {
+	t.Parallel()
+
+	snan := SNaN(7)
+
+	if !snan.IsNaN() || !snan.IsSignalingNaN() || snan.IsQuietNaN() || snan.Class() != SignalingNaN {
+		t.Errorf("SNaN(7) = %v, want signaling NaN", snan)
+	}
+
+	if !NaN().IsQuietNaN() || NaN().IsSignalingNaN() || One.IsQuietNaN() || One.IsSignalingNaN() {
+		t.Errorf("NaN() or 1 reported as signaling NaN")
+	}
+
+	one := One
+	two := Two
+
+	tests := []struct {
+		op  string
+		res Decimal
+	}{
+		{"Add", snan.Add(one)},
+		{"Add", NaN().Add(snan)},
+		{"Sub", one.Sub(snan)},
+		{"Mul", snan.Mul(Inf(1))},
+		{"Quo", snan.Quo(Zero)},
+		{"QuoRem", func() Decimal { q, _ := snan.QuoRem(two); return q }()},
+		{"Remainder", Remainder(one, snan)},
+		{"FMA", FMA(one, NaN(), snan)},
+		{"Pow", snan.Pow(Zero)},
+		{"Pow", one.Pow(snan)},
+		{"Sqrt", Sqrt(snan)},
+		{"Exp", Exp(snan)},
+		{"Log", Log(snan)},
+		{"Round", snan.Round(2, ToNearestEven)},
+		{"ScaleB", ScaleB(snan, 2)},
+		{"NextUp", snan.NextUp()},
+		{"NextToward", one.NextToward(snan)},
+		{"Max", Max(snan, one)},
+		{"Max", Max(one, snan)},
+		{"Min", Min(snan, one)},
+		{"Min", Min(one, snan)},
+	}
+
+	for _, test := range tests {
+		if !test.res.IsQuietNaN() || test.res.Payload() != 7 {
+			t.Errorf("%s(sNaN7) = %v, want NaN7", test.op, test.res)
+		}
+	}
+
+	res, err := snan.Quantize(one, ToNearestEven)
+
+	var cerr *ConditionError
+	if !errors.As(err, &cerr) || cerr.Condition != InvalidOperation || !res.IsQuietNaN() || res.Payload() != 7 {
+		t.Errorf("sNaN7.Quantize(1) = %v, %v, want NaN7, InvalidOperation", res, err)
+	}
+}
+
 func TestSize(t *testing.T) {
 	t.Parallel()
 
@@ -33,6 +33,11 @@ var (
 		exp: -57,
 	}
 
+	halfPi = decomposed192{
+		sig: uint192{0x9972_c894_3467_8497, 0x915e_b869_9bb1_316f, 0x400f_e0fd_2f18_1182},
+		exp: -57,
+	}
+
 	ln = [...]uint192{
 		{0xce06_052e_ed85_0b11, 0xf432_4af7_5d64_cfcb, 0x03e3_15af_624a_52e7}, // ln(1.1)
 		{0xb352_8e25_962a_8d07, 0xa21f_990f_44a0_1c4d, 0x076f_869f_7595_b691}, // ln(1.2)
@@ -362,6 +367,28 @@ func (d decomposed192) add1(trunc int8) (decomposed192, int8) {
 	}, trunc
 }
 
+func (d decomposed192) cos(trunc int8) (decomposed192, int8) {
+	sqr, trunc := d.pow2(trunc)
+	res := decomposed192{
+		sig: uint192{1, 0, 0},
+		exp: 0,
+	}
+
+	// Terms up to d**48/48! are enough for |d| <= 1.
+	for i := uint64(48); i > 0; i -= 2 {
+		// res = 1 - res * d**2 / ((i-1) * i)
+		tmp, _ := sqr.quo(decomposed192{
+			sig: uint192{(i - 1) * i, 0, 0},
+			exp: 0,
+		}, int8(0))
+
+		res, trunc = res.mul(tmp, trunc)
+		_, res, trunc = res.sub1(trunc)
+	}
+
+	return res, trunc
+}
+
 func (d decomposed192) epow(l10 int16, trunc int8) (decomposed192, int8) {
 	exp := d.exp + l10 + 1
 	if exp < 0 {
@@ -442,7 +469,7 @@ func (d decomposed192) log() (bool, decomposed192, int8) {
 
 	res := frc
 
-	for i := uint64(3); i <= 25; i += 2 {
+	for i := uint64(3); i <= 39; i += 2 {
 		// res += frc^i / i
 		frc, _ = frc.mul(sqr, int8(0))
 		tmp, _ := frc.quo(decomposed192{
@@ -792,6 +819,28 @@ func (d decomposed192) rcp(trunc int8) (decomposed192, int8) {
 	}, trunc
 }
 
+func (d decomposed192) sin(trunc int8) (decomposed192, int8) {
+	sqr, trunc := d.pow2(trunc)
+	res := decomposed192{
+		sig: uint192{1, 0, 0},
+		exp: 0,
+	}
+
+	// Terms up to d**49/49! are enough for |d| <= 1.
+	for i := uint64(48); i > 0; i -= 2 {
+		// res = 1 - res * d**2 / (i * (i+1))
+		tmp, _ := sqr.quo(decomposed192{
+			sig: uint192{i * (i + 1), 0, 0},
+			exp: 0,
+		}, int8(0))
+
+		res, trunc = res.mul(tmp, trunc)
+		_, res, trunc = res.sub1(trunc)
+	}
+
+	return res.mul(d, trunc)
+}
+
 func (d decomposed192) sub(o decomposed192, trunc int8) (bool, decomposed192, int8) {
 	exp := d.exp - o.exp
 
@@ -1,23 +1,38 @@
 package decimal128
 
-import "math/bits"
+import (
+	"math/big"
+	"math/bits"
+)
 
 // Exp returns e**d, the base-e exponential of d.
 func Exp(d Decimal) Decimal {
+	res, _ := d.exp(DefaultRounder().rounding())
+	return res
+}
+
+// ExpWithMode returns e**d, the base-e exponential of d, rounded using the
+// provided rounding mode.
+func ExpWithMode(d Decimal, mode RoundingMode) Decimal {
+	res, _ := d.exp(rounding{RoundingMode: mode})
+	return res
+}
+
+func (d Decimal) exp(mode rounding) (Decimal, bool) {
 	if d.isSpecial() {
 		if d.IsNaN() {
-			return d
+			return d.quiet(), false
 		}
 
 		if d.Signbit() {
-			return zero(false)
+			return zero(false), false
 		}
 
-		return inf(false)
+		return inf(false), false
 	}
 
 	if d.IsZero() {
-		return one(false)
+		return one(false), false
 	}
 
 	dSig, dExp := d.decompose()
@@ -26,10 +41,10 @@ func Exp(d Decimal) Decimal {
 
 	if int(dExp) > 5-l10 {
 		if d.Signbit() {
-			return zero(false)
+			return zero(false), true
 		}
 
-		return inf(false)
+		return inf(false), true
 	}
 
 	res, trunc := decomposed192{
@@ -39,45 +54,60 @@ func Exp(d Decimal) Decimal {
 
 	if res.exp > maxUnbiasedExponent+58 {
 		if d.Signbit() {
-			return zero(false)
+			return zero(false), true
 		}
 
-		return inf(false)
+		return inf(false), true
 	}
 
 	if d.Signbit() {
-		res, trunc = res.rcp(trunc)
+		res, _ = res.rcp(trunc)
 	}
 
-	sig, exp := DefaultRoundingMode.reduce192(false, res.sig, res.exp+exponentBias, trunc)
+	sig, exp, ok := mode.reduceApprox(false, res, expDigits(res))
+	if !ok {
+		sig, exp = d.expBig(mode, nil)
+	}
 
 	if exp > maxBiasedExponent {
 		if d.Signbit() {
-			return zero(false)
+			return zero(false), true
 		}
 
-		return inf(false)
+		return inf(false), true
 	}
 
-	return compose(false, sig, exp)
+	return compose(false, sig, exp), true
 }
 
 // Exp10 returns 10**d, the base-10 exponential of d.
 func Exp10(d Decimal) Decimal {
+	res, _ := d.exp10(DefaultRounder().rounding())
+	return res
+}
+
+// Exp10WithMode returns 10**d, the base-10 exponential of d, rounded using the
+// provided rounding mode.
+func Exp10WithMode(d Decimal, mode RoundingMode) Decimal {
+	res, _ := d.exp10(rounding{RoundingMode: mode})
+	return res
+}
+
+func (d Decimal) exp10(mode rounding) (Decimal, bool) {
 	if d.isSpecial() {
 		if d.IsNaN() {
-			return d
+			return d.quiet(), false
 		}
 
 		if d.Signbit() {
-			return zero(false)
+			return zero(false), false
 		}
 
-		return inf(false)
+		return inf(false), false
 	}
 
 	if d.IsZero() {
-		return one(false)
+		return one(false), false
 	}
 
 	dSig, dExp := d.decompose()
@@ -86,10 +116,10 @@ func Exp10(d Decimal) Decimal {
 
 	if int(dExp) > 4-l10 {
 		if d.Signbit() {
-			return zero(false)
+			return zero(false), true
 		}
 
-		return inf(false)
+		return inf(false), true
 	}
 
 	var dSigInt uint
@@ -116,10 +146,10 @@ func Exp10(d Decimal) Decimal {
 
 		if dSigInt > maxUnbiasedExponent+58 {
 			if d.Signbit() {
-				return zero(false)
+				return zero(false), true
 			}
 
-			return inf(false)
+			return inf(false), true
 		}
 
 		sig = dSig
@@ -139,19 +169,7 @@ func Exp10(d Decimal) Decimal {
 	var res decomposed192
 	var trunc int8
 
-	var sigInt uint128
-	var expInt int16
-
-	if dSigInt != 0 {
-		sigInt = uint128{1, 0}
-
-		for dSigInt > maxUnbiasedExponent {
-			sigInt = sigInt.mul64(10)
-			dSigInt--
-		}
-
-		expInt = int16(dSigInt)
-	}
+	expInt := int16(dSigInt)
 
 	if dSig != (uint128{}) {
 		res, trunc = decomposed192{
@@ -163,10 +181,10 @@ func Exp10(d Decimal) Decimal {
 
 		if res.exp > maxUnbiasedExponent+58 {
 			if d.Signbit() {
-				return zero(false)
+				return zero(false), true
 			}
 
-			return inf(false)
+			return inf(false), true
 		}
 
 		if expInt != 0 {
@@ -181,45 +199,71 @@ func Exp10(d Decimal) Decimal {
 
 	if res.exp > maxUnbiasedExponent+58 {
 		if d.Signbit() {
-			return zero(false)
+			return zero(false), true
 		}
 
-		return inf(false)
+		return inf(false), true
 	}
 
 	if d.Signbit() {
 		res, trunc = res.rcp(trunc)
 	}
 
-	sig, exp := DefaultRoundingMode.reduce192(false, res.sig, res.exp+exponentBias, trunc)
+	var sig uint128
+	var exp int16
+	inexact := true
+
+	if dSig == (uint128{}) {
+		// Integral powers of ten are exact.
+		sig, exp, inexact = mode.reduce192(false, res.sig, res.exp+exponentBias, trunc, 0)
+	} else {
+		var ok bool
+		sig, exp, ok = mode.reduceApprox(false, res, expDigits(res))
+
+		if !ok {
+			sig, exp = d.expBig(mode, bigLn10)
+		}
+	}
 
 	if exp > maxBiasedExponent {
 		if d.Signbit() {
-			return zero(false)
+			return zero(false), true
 		}
 
-		return inf(false)
+		return inf(false), true
 	}
 
-	return compose(false, sig, exp)
+	return compose(false, sig, exp), inexact
 }
 
 // Exp2 returns 2**d, the base-2 exponential of d.
 func Exp2(d Decimal) Decimal {
+	res, _ := d.exp2(DefaultRounder().rounding())
+	return res
+}
+
+// Exp2WithMode returns 2**d, the base-2 exponential of d, rounded using the
+// provided rounding mode.
+func Exp2WithMode(d Decimal, mode RoundingMode) Decimal {
+	res, _ := d.exp2(rounding{RoundingMode: mode})
+	return res
+}
+
+func (d Decimal) exp2(mode rounding) (Decimal, bool) {
 	if d.isSpecial() {
 		if d.IsNaN() {
-			return d
+			return d.quiet(), false
 		}
 
 		if d.Signbit() {
-			return zero(false)
+			return zero(false), false
 		}
 
-		return inf(false)
+		return inf(false), false
 	}
 
 	if d.IsZero() {
-		return one(false)
+		return one(false), false
 	}
 
 	dSig, dExp := d.decompose()
@@ -228,10 +272,10 @@ func Exp2(d Decimal) Decimal {
 
 	if int(dExp) > 5-l10 {
 		if d.Signbit() {
-			return zero(false)
+			return zero(false), true
 		}
 
-		return inf(false)
+		return inf(false), true
 	}
 
 	var dSigInt uint
@@ -270,6 +314,37 @@ func Exp2(d Decimal) Decimal {
 		}
 	}
 
+	// As 2**(10*k/3) is greater than 10**k, larger integral parts overflow.
+	if dSigInt > (exponentBias+maxDigits)*10/3 {
+		if d.Signbit() {
+			return zero(false), true
+		}
+
+		return inf(false), true
+	}
+
+	if dSig == (uint128{}) {
+		// Integral powers of two are computed exactly, using 2**-n = 5**n *
+		// 10**-n for negative powers.
+		pow := new(big.Int)
+		e := 0
+
+		if d.Signbit() {
+			pow.Exp(big.NewInt(5), big.NewInt(int64(dSigInt)), nil)
+			e = -int(dSigInt)
+		} else {
+			pow.Lsh(big.NewInt(1), dSigInt)
+		}
+
+		sig, exp, inexact := mode.reduceBig(false, pow, e, 0)
+
+		if exp > maxBiasedExponent {
+			return inf(false), true
+		}
+
+		return compose(false, sig, exp), inexact
+	}
+
 	var res decomposed192
 	var trunc int8
 
@@ -277,14 +352,6 @@ func Exp2(d Decimal) Decimal {
 	var expInt int16
 
 	if dSigInt != 0 {
-		if dSigInt > exponentBias+maxDigits {
-			if d.Signbit() {
-				return zero(false)
-			}
-
-			return inf(false)
-		}
-
 		shift := dSigInt
 
 		if shift < 64 {
@@ -323,8 +390,8 @@ func Exp2(d Decimal) Decimal {
 
 			for sigInt256[3] > 0 {
 				var rem uint64
-				sigInt256, rem = sigInt256.div1e19()
-				expInt += 19
+				sigInt256, rem = sigInt256.div10()
+				expInt++
 
 				if rem != 0 {
 					trunc = 1
@@ -345,10 +412,10 @@ func Exp2(d Decimal) Decimal {
 
 		if res.exp > maxUnbiasedExponent+58 {
 			if d.Signbit() {
-				return zero(false)
+				return zero(false), true
 			}
 
-			return inf(false)
+			return inf(false), true
 		}
 
 		if dSigInt != 0 {
@@ -366,170 +433,292 @@ func Exp2(d Decimal) Decimal {
 
 	if res.exp > maxUnbiasedExponent+maxDigits {
 		if d.Signbit() {
-			return zero(false)
+			return zero(false), true
 		}
 
-		return inf(false)
+		return inf(false), true
 	}
 
 	if d.Signbit() {
-		res, trunc = res.rcp(trunc)
+		res, _ = res.rcp(trunc)
 	}
 
-	sig, exp := DefaultRoundingMode.reduce192(false, res.sig, res.exp+exponentBias, trunc)
+	sig, exp, ok := mode.reduceApprox(false, res, expDigits(res))
+	if !ok {
+		sig, exp = d.expBig(mode, bigLn2)
+	}
 
 	if exp > maxBiasedExponent {
 		if d.Signbit() {
-			return zero(false)
+			return zero(false), true
 		}
 
-		return inf(false)
+		return inf(false), true
 	}
 
-	return compose(false, sig, exp)
+	return compose(false, sig, exp), true
 }
 
 // Log returns the natural logarithm of d.
 func Log(d Decimal) Decimal {
+	res, _ := d.log(DefaultRounder().rounding())
+	return res
+}
+
+// LogWithMode returns the natural logarithm of d, rounded using the provided
+// rounding mode.
+func LogWithMode(d Decimal, mode RoundingMode) Decimal {
+	res, _ := d.log(rounding{RoundingMode: mode})
+	return res
+}
+
+func (d Decimal) log(mode rounding) (Decimal, bool) {
 	if d.isSpecial() {
 		if d.IsNaN() {
-			return d
+			return d.quiet(), false
 		}
 
 		if d.Signbit() {
-			return nan(payloadOpLog, payloadValNegInfinite, 0)
+			return nan(payloadOpLog, payloadValNegInfinite, 0), false
 		}
 
-		return inf(false)
+		return inf(false), false
 	}
 
 	if d.IsZero() {
-		return inf(true)
+		return inf(true), false
 	}
 
 	if d.Signbit() {
-		return nan(payloadOpLog, payloadValNegFinite, 0)
+		return nan(payloadOpLog, payloadValNegFinite, 0), false
+	}
+
+	if d.isOne() {
+		return zero(false), false
 	}
 
 	dSig, dExp := d.decompose()
 
-	neg, res, trunc := decomposed192{
+	neg, res, _ := decomposed192{
 		sig: uint192{dSig[0], dSig[1], 0},
 		exp: dExp - exponentBias,
 	}.log()
 
-	sig, exp := DefaultRoundingMode.reduce192(neg, res.sig, res.exp+exponentBias, trunc)
-
-	if exp > maxBiasedExponent {
-		return inf(neg)
+	sig, exp, ok := mode.reduceApprox(neg, res, logDigits(res))
+	if !ok {
+		sig, exp, neg = d.logBig(mode, nil)
 	}
 
-	return compose(neg, sig, exp)
+	return compose(neg, sig, exp), true
 }
 
 // Log10 returns the decimal logarithm of d.
 func Log10(d Decimal) Decimal {
+	res, _ := d.log10(DefaultRounder().rounding())
+	return res
+}
+
+// Log10WithMode returns the decimal logarithm of d, rounded using the provided
+// rounding mode.
+func Log10WithMode(d Decimal, mode RoundingMode) Decimal {
+	res, _ := d.log10(rounding{RoundingMode: mode})
+	return res
+}
+
+func (d Decimal) log10(mode rounding) (Decimal, bool) {
 	if d.isSpecial() {
 		if d.IsNaN() {
-			return d
+			return d.quiet(), false
 		}
 
 		if d.Signbit() {
-			return nan(payloadOpLog10, payloadValNegInfinite, 0)
+			return nan(payloadOpLog10, payloadValNegInfinite, 0), false
 		}
 
-		return inf(false)
+		return inf(false), false
 	}
 
 	if d.IsZero() {
-		return inf(true)
+		return inf(true), false
 	}
 
 	if d.Signbit() {
-		return nan(payloadOpLog10, payloadValNegFinite, 0)
+		return nan(payloadOpLog10, payloadValNegFinite, 0), false
 	}
 
 	dSig, dExp := d.decompose()
 
+	// The logarithm of a power of ten is an exact integer.
+	for {
+		sig, rem := dSig.div10()
+		if rem != 0 {
+			break
+		}
+
+		dSig = sig
+		dExp++
+	}
+
+	if dSig == (uint128{1, 0}) {
+		exp := int64(dExp) - exponentBias
+		return New(exp, 0), false
+	}
+
 	neg, res, trunc := decomposed192{
 		sig: uint192{dSig[0], dSig[1], 0},
 		exp: dExp - exponentBias,
 	}.log()
 
-	res, trunc = res.mul(invLn10, trunc)
-
-	sig, exp := DefaultRoundingMode.reduce192(neg, res.sig, res.exp+exponentBias, trunc)
+	res, _ = res.mul(invLn10, trunc)
 
-	if exp > maxBiasedExponent {
-		return inf(neg)
+	sig, exp, ok := mode.reduceApprox(neg, res, logDigits(res))
+	if !ok {
+		sig, exp, neg = d.logBig(mode, bigLn10)
 	}
 
-	return compose(neg, sig, exp)
+	return compose(neg, sig, exp), true
 }
 
 // Log2 returns the binary logarithm of d.
 func Log2(d Decimal) Decimal {
+	res, _ := d.log2(DefaultRounder().rounding())
+	return res
+}
+
+// Log2WithMode returns the binary logarithm of d, rounded using the provided
+// rounding mode.
+func Log2WithMode(d Decimal, mode RoundingMode) Decimal {
+	res, _ := d.log2(rounding{RoundingMode: mode})
+	return res
+}
+
+func (d Decimal) log2(mode rounding) (Decimal, bool) {
 	if d.isSpecial() {
 		if d.IsNaN() {
-			return d
+			return d.quiet(), false
 		}
 
 		if d.Signbit() {
-			return nan(payloadOpLog2, payloadValNegInfinite, 0)
+			return nan(payloadOpLog2, payloadValNegInfinite, 0), false
 		}
 
-		return inf(false)
+		return inf(false), false
 	}
 
 	if d.IsZero() {
-		return inf(true)
+		return inf(true), false
 	}
 
 	if d.Signbit() {
-		return nan(payloadOpLog2, payloadValNegFinite, 0)
+		return nan(payloadOpLog2, payloadValNegFinite, 0), false
 	}
 
 	dSig, dExp := d.decompose()
 
+	// The logarithm of a power of two is an exact integer. Powers of two are
+	// a power of two or five times a power of ten once trailing zeros are
+	// removed, as 2**-n = 5**n * 10**-n.
+	sig2, exp2 := dSig, int64(dExp)-exponentBias
+
+	for {
+		sig, rem := sig2.div10()
+		if rem != 0 {
+			break
+		}
+
+		sig2 = sig
+		exp2++
+	}
+
+	if bits.OnesCount64(sig2[0])+bits.OnesCount64(sig2[1]) == 1 && exp2 == 0 {
+		n := bits.TrailingZeros64(sig2[0])
+		if sig2[0] == 0 {
+			n = 64 + bits.TrailingZeros64(sig2[1])
+		}
+
+		return New(int64(n), 0), false
+	}
+
+	var n5 int64
+	for {
+		sig, rem := sig2.div(uint128{5, 0})
+		if rem != (uint128{}) {
+			break
+		}
+
+		sig2 = sig
+		n5++
+	}
+
+	if sig2 == (uint128{1, 0}) && n5+exp2 == 0 {
+		return New(exp2, 0), false
+	}
+
 	neg, res, trunc := decomposed192{
 		sig: uint192{dSig[0], dSig[1], 0},
 		exp: dExp - exponentBias,
 	}.log()
 
-	res, trunc = res.mul(invLn2, trunc)
-
-	sig, exp := DefaultRoundingMode.reduce192(neg, res.sig, res.exp+exponentBias, trunc)
+	res, _ = res.mul(invLn2, trunc)
 
-	if exp > maxBiasedExponent {
-		return inf(neg)
+	sig, exp, ok := mode.reduceApprox(neg, res, logDigits(res))
+	if !ok {
+		sig, exp, neg = d.logBig(mode, bigLn2)
 	}
 
-	return compose(neg, sig, exp)
+	return compose(neg, sig, exp), true
 }
 
 // Sqrt returns the square root of d.
 func Sqrt(d Decimal) Decimal {
+	res, _ := d.sqrt(DefaultRounder().rounding())
+	return res
+}
+
+// SqrtWithMode returns the square root of d, rounded using the provided
+// rounding mode.
+func SqrtWithMode(d Decimal, mode RoundingMode) Decimal {
+	res, _ := d.sqrt(rounding{RoundingMode: mode})
+	return res
+}
+
+func (d Decimal) sqrt(mode rounding) (Decimal, bool) {
 	if d.isSpecial() {
 		if d.IsNaN() {
-			return d
+			return d.quiet(), false
 		}
 
 		if d.Signbit() {
-			return nan(payloadOpSqrt, payloadValNegInfinite, 0)
+			return nan(payloadOpSqrt, payloadValNegInfinite, 0), false
 		}
 
-		return d
+		return d, false
 	}
 
 	if d.IsZero() {
-		return d
+		return d, false
 	}
 
 	if d.Signbit() {
-		return nan(payloadOpSqrt, payloadValNegFinite, 0)
+		return nan(payloadOpSqrt, payloadValNegFinite, 0), false
 	}
 
 	dSig, dExp := d.decompose()
+
+	// An exact square root has the preferred exponent floor(dExp/2), and it
+	// is exact if the coefficient scaled to an even exponent is a perfect
+	// square.
+	sqSig, sqExp := dSig, dExp-exponentBias
+	if sqExp&1 != 0 {
+		sqSig = sqSig.mul64(10)
+		sqExp--
+	}
+
+	root := sqSig.sqrt()
+	if hi, lo := bits.Mul64(root, root); (uint128{lo, hi}) == sqSig {
+		return compose(false, uint128{root, 0}, sqExp/2+exponentBias), false
+	}
+
 	l10 := int16(dSig.log10())
 	dExp = (dExp - exponentBias) + l10
 
@@ -586,11 +775,17 @@ func Sqrt(d Decimal) Decimal {
 	}
 
 	res.exp += dExp / 2
-	sig, exp := DefaultRoundingMode.reduce192(false, res.sig, res.exp+exponentBias, trunc)
+
+	sig, exp, ok := mode.reduceApprox(false, res, 54)
+	inexact := true
+
+	if !ok {
+		sig, exp, inexact = d.sqrtBig(mode)
+	}
 
 	if exp > maxBiasedExponent {
-		return inf(false)
+		return inf(false), true
 	}
 
-	return compose(false, sig, exp)
+	return compose(false, sig, exp), inexact
 }
@@ -20,6 +20,26 @@ func TestExp(t *testing.T) {
 	}
 }
 
+func TestExpWithMode(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var res testDataResult
+
+	for r.scan("exp(%v) = %v\n", &val, &res) {
+		for _, mode := range roundingModes {
+			exp := ExpWithMode(val, mode)
+
+			if !res.equal(exp, mode) {
+				t.Errorf("ExpWithMode(%v, %v) = %v, want %v", val, mode, exp, res.result(mode))
+			}
+		}
+	}
+}
+
 func TestExp10(t *testing.T) {
 	t.Parallel()
 
@@ -38,6 +58,26 @@ func TestExp10(t *testing.T) {
 	}
 }
 
+func TestExp10WithMode(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var res testDataResult
+
+	for r.scan("exp10(%v) = %v\n", &val, &res) {
+		for _, mode := range roundingModes {
+			exp := Exp10WithMode(val, mode)
+
+			if !res.equal(exp, mode) {
+				t.Errorf("Exp10WithMode(%v, %v) = %v, want %v", val, mode, exp, res.result(mode))
+			}
+		}
+	}
+}
+
 func TestExp2(t *testing.T) {
 	t.Parallel()
 
@@ -56,6 +96,26 @@ func TestExp2(t *testing.T) {
 	}
 }
 
+func TestExp2WithMode(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var res testDataResult
+
+	for r.scan("exp2(%v) = %v\n", &val, &res) {
+		for _, mode := range roundingModes {
+			exp := Exp2WithMode(val, mode)
+
+			if !res.equal(exp, mode) {
+				t.Errorf("Exp2WithMode(%v, %v) = %v, want %v", val, mode, exp, res.result(mode))
+			}
+		}
+	}
+}
+
 func TestLog(t *testing.T) {
 	t.Parallel()
 
@@ -74,6 +134,26 @@ func TestLog(t *testing.T) {
 	}
 }
 
+func TestLogWithMode(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var res testDataResult
+
+	for r.scan("log(%v) = %v\n", &val, &res) {
+		for _, mode := range roundingModes {
+			log := LogWithMode(val, mode)
+
+			if !res.equal(log, mode) {
+				t.Errorf("LogWithMode(%v, %v) = %v, want %v", val, mode, log, res.result(mode))
+			}
+		}
+	}
+}
+
 func TestLog10(t *testing.T) {
 	t.Parallel()
 
@@ -92,6 +172,26 @@ func TestLog10(t *testing.T) {
 	}
 }
 
+func TestLog10WithMode(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var res testDataResult
+
+	for r.scan("log10(%v) = %v\n", &val, &res) {
+		for _, mode := range roundingModes {
+			log := Log10WithMode(val, mode)
+
+			if !res.equal(log, mode) {
+				t.Errorf("Log10WithMode(%v, %v) = %v, want %v", val, mode, log, res.result(mode))
+			}
+		}
+	}
+}
+
 func TestLog2(t *testing.T) {
 	t.Parallel()
 
@@ -110,6 +210,26 @@ func TestLog2(t *testing.T) {
 	}
 }
 
+func TestLog2WithMode(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var res testDataResult
+
+	for r.scan("log2(%v) = %v\n", &val, &res) {
+		for _, mode := range roundingModes {
+			log := Log2WithMode(val, mode)
+
+			if !res.equal(log, mode) {
+				t.Errorf("Log2WithMode(%v, %v) = %v, want %v", val, mode, log, res.result(mode))
+			}
+		}
+	}
+}
+
 func TestSqrt(t *testing.T) {
 	t.Parallel()
 
@@ -128,6 +248,62 @@ func TestSqrt(t *testing.T) {
 	}
 }
 
+func TestSqrtWithMode(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var res testDataResult
+
+	for r.scan("sqrt(%v) = %v\n", &val, &res) {
+		for _, mode := range roundingModes {
+			root := SqrtWithMode(val, mode)
+
+			if !res.equal(root, mode) {
+				t.Errorf("SqrtWithMode(%v, %v) = %v, want %v", val, mode, root, res.result(mode))
+			}
+		}
+	}
+}
+
+func TestSqrtExact(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		val string
+		res string
+	}{
+		{"4", "2"},
+		{"4.00", "2.0"},
+		{"40e-1", "2.0"},
+		{"0.04", "0.2"},
+		{"1e2", "1e1"},
+		{"100", "10"},
+		{"1.21", "1.1"},
+		{"1.44e-10", "1.2e-5"},
+		{"1e-6176", "1e-3088"},
+		{"16e-6176", "4e-3088"},
+		{"9e6110", "3e3055"},
+		{"1.0000000000000000000000000000000000", "1.00000000000000000"},
+		{"9999999999999999800000000000000001", "99999999999999999"},
+	}
+
+	for _, test := range tests {
+		val := MustParse(test.val)
+		want := MustParse(test.res)
+
+		for _, mode := range roundingModes {
+			res, inexact := val.sqrt(rounding{RoundingMode: mode})
+
+			if !resultEqual(res, want) || !res.SameQuantum(want) || inexact {
+				t.Errorf("SqrtWithMode(%v, %v) = %v, %t, want %v, false", val, mode, res, inexact, want)
+			}
+		}
+	}
+}
+
 func BenchmarkExp(b *testing.B) {
 	initDecimalValues()
 
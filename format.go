@@ -1,12 +1,15 @@
 package decimal128
 
 import (
+	"bytes"
 	"fmt"
+	"strconv"
 	"unsafe"
 )
 
 var (
 	nanText    = []byte("NaN")
+	negNaNText = []byte("-NaN")
 	padNaNText = []byte(" NaN")
 	posNaNText = []byte("+NaN")
 	negInfText = []byte("-Inf")
@@ -74,6 +77,27 @@ func (d Decimal) Append(buf []byte, format string) []byte {
 	return d.format(buf, &args)
 }
 
+// FormatSig rounds d to n significant digits using the rounding mode
+// provided, as [Decimal.RoundSig] does, and formats the result the same way
+// as the '%.ng' verb in Format. Unlike '%.ng', trailing zeros are kept so
+// that exactly n significant digits are printed, such as "1.20" for 1.2 and
+// n = 3, while a decimal point without any digits after it is not.
+func (d Decimal) FormatSig(n int, mode RoundingMode) string {
+	if n < 1 {
+		n = 1
+	}
+
+	buf := d.RoundSig(n, mode).Append(nil, "#."+strconv.Itoa(n)+"g")
+
+	if i := bytes.IndexAny(buf, "eE"); i > 0 && buf[i-1] == '.' {
+		buf = append(buf[:i-1], buf[i:]...)
+	} else if len(buf) > 0 && buf[len(buf)-1] == '.' {
+		buf = buf[:len(buf)-1]
+	}
+
+	return string(buf)
+}
+
 // Format implements the [fmt.Formatter] interface. It supports the verbs 'e',
 // 'E', 'f', 'F', 'g', 'G', and 'v', along with the format flags '+', '-', '#',
 // ' ', and '0' and custom width and precision values. Decimal values interpret
@@ -183,13 +207,20 @@ func (d Decimal) String() string {
 func (d Decimal) appendSpecial(buf []byte, pad int, printSign, padSign, padRight bool) []byte {
 	var value []byte
 	if d.IsNaN() {
-		if printSign {
+		if d.Signbit() {
+			value = negNaNText
+		} else if printSign {
 			value = posNaNText
 		} else if padSign {
 			value = padNaNText
 		} else {
 			value = nanText
 		}
+
+		if d.isSignaling() || d.nanPayload() != (uint128{}) {
+			n := len(value) - len(nanText)
+			value = d.appendNaN(value[:n:n])
+		}
 	} else {
 		if d.Signbit() {
 			value = negInfText
@@ -233,6 +264,22 @@ func (d Decimal) appendSpecial(buf []byte, pad int, printSign, padSign, padRight
 	return buf
 }
 
+// appendNaN appends "NaN", or "sNaN" if d is a signaling NaN, to buf followed
+// by the digits of the payload of d returned by nanPayload.
+func (d Decimal) appendNaN(buf []byte) []byte {
+	if d.isSignaling() {
+		buf = append(buf, 's')
+	}
+
+	buf = append(buf, nanText...)
+
+	if payload := d.nanPayload(); payload != (uint128{}) {
+		buf = append(buf, payload.String()...)
+	}
+
+	return buf
+}
+
 func (d Decimal) digits(digs *digits) {
 	*digs = digits{}
 	digs.neg = d.Signbit()
@@ -444,13 +491,20 @@ func (d Decimal) format(buf []byte, args *formatArgs) []byte {
 func (d Decimal) writeSpecial(f fmt.State, pad int, printSign, padSign, padRight bool) {
 	var value []byte
 	if d.IsNaN() {
-		if printSign {
+		if d.Signbit() {
+			value = negNaNText
+		} else if printSign {
 			value = posNaNText
 		} else if padSign {
 			value = padNaNText
 		} else {
 			value = nanText
 		}
+
+		if d.isSignaling() || d.nanPayload() != (uint128{}) {
+			n := len(value) - len(nanText)
+			value = d.appendNaN(value[:n:n])
+		}
 	} else {
 		if d.Signbit() {
 			value = negInfText
@@ -104,6 +104,79 @@ func TestDecimalFormat(t *testing.T) {
 	}
 }
 
+func TestDecimalFormatSig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		val  string
+		n    int
+		mode RoundingMode
+		want string
+	}{
+		{"1.2", 3, ToNearestEven, "1.20"},
+		{"1.2", 1, ToNearestEven, "1"},
+		{"1.25", 2, ToNearestEven, "1.2"},
+		{"1.25", 2, ToNearestAway, "1.3"},
+		{"-1.25", 2, ToPositiveInf, "-1.2"},
+		{"-1.25", 2, ToNegativeInf, "-1.3"},
+		{"123456", 6, ToNearestEven, "123456"},
+		{"123456", 3, ToNearestEven, "1.23e+05"},
+		{"123456", 1, ToZero, "1e+05"},
+		{"999.9", 3, ToNearestEven, "1.00e+03"},
+		{"999.9", 4, ToNearestEven, "999.9"},
+		{"999.9", 3, ToZero, "999"},
+		{"0.000123456", 2, AwayFromZero, "0.00013"},
+		{"1e-10", 3, ToNearestEven, "1.00e-10"},
+		{"0", 3, ToNearestEven, "0.00"},
+		{"-0.5", 0, ToNearestEven, "-0.5"},
+		{"Inf", 3, ToNearestEven, "+Inf"},
+		{"-Inf", 3, ToNearestEven, "-Inf"},
+		{"NaN", 3, ToNearestEven, "NaN"},
+	}
+
+	for _, test := range tests {
+		val := MustParse(test.val)
+
+		if res := val.FormatSig(test.n, test.mode); res != test.want {
+			t.Errorf("%v.FormatSig(%d, %v) = %q, want %q", val, test.n, test.mode, res, test.want)
+		}
+	}
+}
+
+func TestDecimalFormatNaN(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		val    Decimal
+		format string
+		want   string
+	}{
+		{SNaN(0), "%v", "sNaN"},
+		{SNaN(42), "%v", "sNaN42"},
+		{SNaN(42).Add(One), "%v", "NaN42"},
+		{SNaN(42), "%+e", "+sNaN42"},
+		{SNaN(42), "% f", " sNaN42"},
+		{SNaN(42), "%8g", "  sNaN42"},
+		{SNaN(42), "%-8g|", "sNaN42  |"},
+		{MustParse("NaN7"), "%.2f", "NaN7"},
+		{NaN(), "%+g", "+NaN"},
+		{Zero.Quo(Zero), "%v", "NaN"},
+	}
+
+	for _, test := range tests {
+		if res := fmt.Sprintf(test.format, test.val); res != test.want {
+			t.Errorf("fmt.Sprintf(%s, %v) = %q, want %q", test.format, test.val, res, test.want)
+		}
+
+		format := strings.TrimPrefix(strings.TrimSuffix(test.format, "|"), "%")
+		want := strings.TrimSuffix(test.want, "|")
+
+		if res := string(test.val.Append(nil, format)); res != want {
+			t.Errorf("%v.Append(%s) = %q, want %q", test.val, format, res, want)
+		}
+	}
+}
+
 func TestDecimalMarshalText(t *testing.T) {
 	t.Parallel()
 
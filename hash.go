@@ -0,0 +1,46 @@
+package decimal128
+
+import (
+	"encoding/binary"
+	"hash/maphash"
+)
+
+// Key is a comparable representation of a Decimal that can be used as a map
+// key. Unlike a Decimal, whose members of a cohort (such as 1.0 and 1.00) have
+// different representations, all values that are equal produce the same Key.
+// Both +0 and -0 produce the same Key, and all NaN values produce the same
+// Key.
+type Key struct {
+	d Decimal
+}
+
+// Key returns the canonical map key of d. Two Decimals have the same Key if
+// they are equal, or if they are both NaN.
+func (d Decimal) Key() Key {
+	c := d.Canonical()
+
+	if c.IsZero() {
+		c = zero(false).Canonical()
+	}
+
+	return Key{c}
+}
+
+// Decimal returns the canonical Decimal that k was derived from, as returned
+// by [Decimal.Canonical]. The Decimal is +0 if k was derived from -0.
+func (k Key) Decimal() Decimal {
+	return k.d
+}
+
+// Hash returns a hash of d using the given seed. Decimals that are equal have
+// the same hash, regardless of their exponent or the sign of a zero. All NaN
+// values have the same hash.
+func (d Decimal) Hash(seed maphash.Seed) uint64 {
+	k := d.Key()
+
+	var buf [16]byte
+	binary.LittleEndian.PutUint64(buf[:8], k.d.lo)
+	binary.LittleEndian.PutUint64(buf[8:], k.d.hi)
+
+	return maphash.Bytes(seed, buf[:])
+}
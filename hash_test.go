@@ -0,0 +1,62 @@
+package decimal128
+
+import (
+	"hash/maphash"
+	"testing"
+)
+
+func TestDecimalHash(t *testing.T) {
+	t.Parallel()
+
+	seed := maphash.MakeSeed()
+
+	cohorts := [][]Decimal{
+		{MustParse("0"), MustParse("-0"), MustParse("0.000"), MustParse("-0e100"), MustParse("0e-6176"), MustParse("0e6111")},
+		{MustParse("1"), MustParse("1.0"), MustParse("1.00"), MustParse("100e-2"), New(1, 0), New(10000, -4)},
+		{MustParse("-12.3"), MustParse("-12.30"), MustParse("-1230e-2"), New(-123, -1)},
+		{MustParse("1e10"), MustParse("10e9"), MustParse("10000000000"), MustParse("10000000000.000")},
+		{MustParse("1e6144"), MustParse("1000000000000000000000000000000000e6111")},
+		{MustParse("1e-6176"), MustParse("0.1e-6175")},
+		{MustParse("Inf"), MustParse("+Inf")},
+		{MustParse("-Inf")},
+		{MustParse("NaN"), MustParse("NaN123"), MustParse("sNaN"), MustParse("sNaN45"), MustParse("-NaN")},
+	}
+
+	for i, cohort := range cohorts {
+		key := cohort[0].Key()
+		hash := cohort[0].Hash(seed)
+
+		for _, val := range cohort[1:] {
+			if !val.IsNaN() && !val.Equal(cohort[0]) {
+				t.Fatalf("%v and %v are not equal", val, cohort[0])
+			}
+
+			if res := val.Key(); res != key {
+				t.Errorf("%v.Key() = %v, want %v", val, res, key)
+			}
+
+			if res := val.Hash(seed); res != hash {
+				t.Errorf("%v.Hash() = %d, want %d", val, res, hash)
+			}
+		}
+
+		for _, other := range cohorts[i+1:] {
+			if other[0].Key() == key {
+				t.Errorf("%v.Key() == %v.Key()", other[0], cohort[0])
+			}
+		}
+	}
+
+	m := make(map[Key]int)
+	for _, val := range []Decimal{MustParse("1.5"), MustParse("1.50"), MustParse("-0"), MustParse("0.0"), MustParse("1.500")} {
+		m[val.Key()]++
+	}
+
+	if len(m) != 2 || m[MustParse("1.5").Key()] != 3 || m[Zero.Key()] != 2 {
+		t.Errorf("map keyed by Key = %v, want 2 entries with counts 3 and 2", m)
+	}
+
+	if res := MustParse("-0.00").Key().Decimal(); res.Signbit() || !res.IsZero() {
+		t.Errorf("Key(-0.00).Decimal() = %v, want 0", res)
+	}
+}
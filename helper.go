@@ -86,3 +86,11 @@ type Descending []Decimal
 func (s Descending) Len() int           { return len(s) }
 func (s Descending) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
 func (s Descending) Less(i, j int) bool { return s[i].Cmp(s[j]) > 0 }
+
+// TotalSlice sorts Decimals in ascending order using [TotalOrder], which places
+// every value, including NaNs, in a deterministic position.
+type TotalSlice []Decimal
+
+func (s TotalSlice) Len() int           { return len(s) }
+func (s TotalSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s TotalSlice) Less(i, j int) bool { return TotalOrder(s[i], s[j]) < 0 }
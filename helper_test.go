@@ -93,3 +93,40 @@ func TestSortInterface(t *testing.T) {
 	assert.Equal(t, "3", slice[2].String())
 	assert.Equal(t, "5", slice[3].String())
 }
+
+func TestTotalSlice(t *testing.T) {
+	slice := TotalSlice{
+		MustParse("1.00"),
+		NaN(),
+		MustParse("-0"),
+		SNaN(3).Neg(),
+		MustParse("1.0"),
+		Inf(-1),
+		MustParse("NaN5").Neg(),
+		SNaN(3),
+		MustParse("0"),
+		MustParse("-1"),
+		Inf(1),
+		MustParse("-1.0"),
+	}
+	sort.Sort(slice)
+
+	want := TotalSlice{
+		MustParse("NaN5").Neg(),
+		SNaN(3).Neg(),
+		Inf(-1),
+		MustParse("-1"),
+		MustParse("-1.0"),
+		MustParse("-0"),
+		MustParse("0"),
+		MustParse("1.00"),
+		MustParse("1.0"),
+		Inf(1),
+		SNaN(3),
+		NaN(),
+	}
+
+	for i, v := range want {
+		assert.Equal(t, v, slice[i])
+	}
+}
@@ -269,6 +269,11 @@ func (n uint128) div1e19() (uint128, uint64) {
 	return uint128{r0, r1}, rem
 }
 
+func (n uint128) last05() bool {
+	_, rem := n.div10()
+	return rem == 0 || rem == 5
+}
+
 func (n uint128) log10() int {
 	var l2 int
 	if n[1] != 0 {
@@ -343,6 +348,10 @@ func (n uint128) or64(o uint64) uint128 {
 	return uint128{n[0] | o, n[1]}
 }
 
+func (n uint128) pow(e uint64) (uint256, bool) {
+	return uint256{n[0], n[1], 0, 0}.pow(e)
+}
+
 func (n uint128) rsh(o uint) uint128 {
 	var r0, r1 uint64
 	if o > 64 {
@@ -355,6 +364,36 @@ func (n uint128) rsh(o uint) uint128 {
 	return uint128{r0, r1}
 }
 
+// sqrt returns the integer square root of n, which must be less than 2**126.
+func (n uint128) sqrt() uint64 {
+	r := uint64(math.Sqrt(float64(n[1])*0x1p64 + float64(n[0])))
+
+	if r != 0 && n[1] < r {
+		q, _ := bits.Div64(n[1], n[0], r)
+		r = (r + q) / 2
+	}
+
+	for {
+		hi, lo := bits.Mul64(r, r)
+		if (uint128{lo, hi}).cmp(n) <= 0 {
+			break
+		}
+
+		r--
+	}
+
+	for {
+		hi, lo := bits.Mul64(r+1, r+1)
+		if (uint128{lo, hi}).cmp(n) > 0 {
+			break
+		}
+
+		r++
+	}
+
+	return r
+}
+
 func (n uint128) sub(o uint128) (uint128, uint) {
 	r0, borrow := bits.Sub64(n[0], o[0], 0)
 	r1, borrow := bits.Sub64(n[1], o[1], borrow)
@@ -847,6 +886,29 @@ func (n uint256) String() string {
 	return string(buf[i:])
 }
 
+func (n uint256) add(o uint256) uint256 {
+	r0, carry := bits.Add64(n[0], o[0], 0)
+	r1, carry := bits.Add64(n[1], o[1], carry)
+	r2, carry := bits.Add64(n[2], o[2], carry)
+	r3, _ := bits.Add64(n[3], o[3], carry)
+
+	return uint256{r0, r1, r2, r3}
+}
+
+func (n uint256) cmp(o uint256) int {
+	for i := 3; i >= 0; i-- {
+		if n[i] != o[i] {
+			if n[i] < o[i] {
+				return -1
+			}
+
+			return 1
+		}
+	}
+
+	return 0
+}
+
 func (n uint256) div10() (uint256, uint64) {
 	var r2, r3, rem uint64
 	if n[3] < 10 {
@@ -928,6 +990,33 @@ func (n uint256) lsh(o uint) uint256 {
 	return uint256{r0, r1, r2, r3}
 }
 
+func (n uint256) mul(o uint256) (uint256, bool) {
+	var r [8]uint64
+	for i := range n {
+		if n[i] == 0 {
+			continue
+		}
+
+		var carry uint64
+		for j := range o {
+			hi, lo := bits.Mul64(n[i], o[j])
+
+			var c uint64
+			lo, c = bits.Add64(lo, r[i+j], 0)
+			hi += c
+			lo, c = bits.Add64(lo, carry, 0)
+			hi += c
+
+			r[i+j] = lo
+			carry = hi
+		}
+
+		r[i+len(o)] = carry
+	}
+
+	return uint256{r[0], r[1], r[2], r[3]}, r[4]|r[5]|r[6]|r[7] == 0
+}
+
 func (n uint256) mul64(o uint64) uint256 {
 	s1, r0 := bits.Mul64(n[0], o)
 	t2, t1 := bits.Mul64(n[1], o)
@@ -941,6 +1030,27 @@ func (n uint256) mul64(o uint64) uint256 {
 	return uint256{r0, r1, r2, r3}
 }
 
+func (n uint256) pow(e uint64) (uint256, bool) {
+	res := uint256{1, 0, 0, 0}
+
+	for ; e != 0; e >>= 1 {
+		var ok bool
+		if e&1 != 0 {
+			if res, ok = res.mul(n); !ok {
+				return uint256{}, false
+			}
+		}
+
+		if e > 1 {
+			if n, ok = n.mul(n); !ok {
+				return uint256{}, false
+			}
+		}
+	}
+
+	return res, true
+}
+
 func (n uint256) rsh(o uint) uint256 {
 	var r0, r1, r2, r3 uint64
 	if o > 192 {
@@ -962,6 +1072,15 @@ func (n uint256) rsh(o uint) uint256 {
 	return uint256{r0, r1, r2, r3}
 }
 
+func (n uint256) sub(o uint256) (uint256, uint) {
+	r0, borrow := bits.Sub64(n[0], o[0], 0)
+	r1, borrow := bits.Sub64(n[1], o[1], borrow)
+	r2, borrow := bits.Sub64(n[2], o[2], borrow)
+	r3, borrow := bits.Sub64(n[3], o[3], borrow)
+
+	return uint256{r0, r1, r2, r3}, uint(borrow)
+}
+
 type uint384 [6]uint64
 
 func (n uint384) String() string {
@@ -514,6 +514,28 @@ func TestUint128Or64(t *testing.T) {
 	}
 }
 
+func TestUint128Pow(t *testing.T) {
+	t.Parallel()
+
+	initUintValues()
+
+	bigval := new(big.Int)
+	tmppow := new(big.Int)
+
+	for _, val := range uint128Values {
+		for _, e := range []uint64{0, 1, 2, 3, 5, 10, 64, 113, 256} {
+			pow, ok := val.pow(e)
+
+			uint128ToBig(val, bigval)
+			bigpow := bigval.Exp(bigval, new(big.Int).SetUint64(e), nil)
+
+			if want := bigpow.BitLen() <= 256; ok != want || ok && uint256ToBig(pow, tmppow).Cmp(bigpow) != 0 {
+				t.Errorf("%v.pow(%d) = (%v, %t), want (%v, %t)", val, e, pow, ok, bigpow, want)
+			}
+		}
+	}
+}
+
 func TestUint128Rsh(t *testing.T) {
 	t.Parallel()
 
@@ -536,6 +558,29 @@ func TestUint128Rsh(t *testing.T) {
 	}
 }
 
+func TestUint128Sqrt(t *testing.T) {
+	t.Parallel()
+
+	initUintValues()
+
+	bigval := new(big.Int)
+
+	for _, val := range uint128Values {
+		if val[1] >= 1<<62 {
+			continue
+		}
+
+		res := val.sqrt()
+
+		uint128ToBig(val, bigval)
+		bigres := bigval.Sqrt(bigval)
+
+		if !bigres.IsUint64() || res != bigres.Uint64() {
+			t.Errorf("%v.sqrt() = %d, want %v", val, res, bigres)
+		}
+	}
+}
+
 func TestUint128String(t *testing.T) {
 	t.Parallel()
 
@@ -1290,6 +1335,31 @@ func TestUint256Lsh(t *testing.T) {
 	}
 }
 
+func TestUint256Mul(t *testing.T) {
+	t.Parallel()
+
+	initUintValues()
+
+	biglhs := new(big.Int)
+	bigrhs := new(big.Int)
+	tmpprd := new(big.Int)
+
+	for _, lhs := range uint256Values {
+		for i := 0; i < len(uint256Values); i += 17 {
+			rhs := uint256Values[i]
+			prd, ok := lhs.mul(rhs)
+
+			uint256ToBig(lhs, biglhs)
+			uint256ToBig(rhs, bigrhs)
+			bigprd := biglhs.Mul(biglhs, bigrhs)
+
+			if want := bigprd.BitLen() <= 256; ok != want || ok && uint256ToBig(prd, tmpprd).Cmp(bigprd) != 0 {
+				t.Errorf("%v.mul(%v) = (%v, %t), want (%v, %t)", lhs, rhs, prd, ok, bigprd, want)
+			}
+		}
+	}
+}
+
 func TestUint256Mul64(t *testing.T) {
 	t.Parallel()
 
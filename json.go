@@ -58,7 +58,7 @@ func (d *Decimal) UnmarshalJSON(data []byte) error {
 		i = 1
 	}
 
-	tmp, err := parseNumber(data[i:], neg, false)
+	tmp, err := parseNumber(data[i:], neg, false, DefaultRounder().rounding())
 	if err != nil {
 		switch err := err.(type) {
 		case parseNumberRangeError:
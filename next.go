@@ -0,0 +1,173 @@
+package decimal128
+
+import "math/big"
+
+var (
+	// maxSig is the largest significand that can be encoded, which has 35
+	// digits.
+	maxSig = uint128{0xffff_ffff_ffff_ffff, 0x0002_7fff_ffff_ffff}
+
+	// minFullSig is the smallest significand that cannot be multiplied by 10
+	// without exceeding maxSig, one greater than maxSig / 10.
+	minFullSig = uint128{0x0000_0000_0000_0000, 0x0000_4000_0000_0000}
+
+	// fullSigCount is the number of significands from minFullSig to maxSig,
+	// which is the number of distinct values with each biased exponent
+	// greater than zero.
+	fullSigCount = uint128{0x0000_0000_0000_0000, 0x0002_4000_0000_0000}
+)
+
+// NextDown returns the largest representable value that is less than d. The
+// result is -Inf if d is the smallest finite value, and NextDown(+Inf) is the
+// largest finite value. A NaN is returned as a quiet NaN with the same payload.
+func (d Decimal) NextDown() Decimal {
+	return d.Neg().NextUp().Neg()
+}
+
+// NextToward returns the representable value adjacent to d in the direction
+// of o. If d and o are equal, d is returned with the sign of o. If either
+// value is NaN then NaN is returned.
+func (d Decimal) NextToward(o Decimal) Decimal {
+	if d.IsNaN() || o.IsNaN() {
+		return propagateNaN(d, o)
+	}
+
+	switch Compare(d, o) {
+	case -1:
+		return d.NextUp()
+	case 1:
+		return d.NextDown()
+	}
+
+	if d.Signbit() != o.Signbit() {
+		return d.Neg()
+	}
+
+	return d
+}
+
+// NextUp returns the smallest representable value that is greater than d.
+// The result is +Inf if d is the largest finite value, and NextUp(-Inf) is
+// the smallest finite value. NextUp of ±0 is the smallest positive subnormal
+// value, 1E-6176. A NaN is returned as a quiet NaN with the same payload.
+func (d Decimal) NextUp() Decimal {
+	if d.isSpecial() {
+		if d.isInf() && d.Signbit() {
+			return compose(true, maxSig, maxBiasedExponent)
+		}
+
+		return d.quiet()
+	}
+
+	sig, exp := d.fullPrecision()
+
+	if sig == (uint128{}) {
+		return compose(false, uint128{1, 0}, 0)
+	}
+
+	if !d.Signbit() {
+		sig = sig.add64(1)
+
+		if sig.cmp(maxSig) > 0 {
+			sig = minFullSig
+			exp++
+		}
+
+		if exp > maxBiasedExponent {
+			return inf(false)
+		}
+
+		return compose(false, sig, exp)
+	}
+
+	if sig == minFullSig && exp > 0 {
+		return compose(true, maxSig, exp-1)
+	}
+
+	sig = sig.sub64(1)
+
+	if sig == (uint128{}) {
+		return zero(true)
+	}
+
+	return compose(true, sig, exp)
+}
+
+// ULPDistance returns the number of representable values between d and o,
+// counting one of the two. The distance between a value and its [NextUp] is
+// 1, as is the distance between the largest finite value and +Inf. Members of
+// the same cohort and ±0 have a distance of 0. If either value is NaN the
+// result is nil.
+func ULPDistance(d, o Decimal) *big.Int {
+	if d.IsNaN() || o.IsNaN() {
+		return nil
+	}
+
+	dOrd := d.ordinal()
+	oOrd := o.ordinal()
+
+	var dist uint128
+	if d.Signbit() != o.Signbit() {
+		sum := dOrd.add(oOrd)
+		dist = uint128{sum[0], sum[1]}
+	} else if dOrd.cmp(oOrd) >= 0 {
+		dist, _ = dOrd.sub(oOrd)
+	} else {
+		dist, _ = oOrd.sub(dOrd)
+	}
+
+	i := new(big.Int).SetUint64(dist[1])
+	i.Lsh(i, 64)
+
+	return i.Or(i, new(big.Int).SetUint64(dist[0]))
+}
+
+// Ulp returns the unit in the last place of d, the positive distance between
+// |d| and the next representable value of greater magnitude when d is
+// written with the smallest possible exponent. Ulp of ±0 is the smallest
+// positive subnormal value, Ulp of ±Inf is +Inf and Ulp of NaN is NaN.
+func (d Decimal) Ulp() Decimal {
+	if d.isSpecial() {
+		if d.isInf() {
+			return inf(false)
+		}
+
+		return d.quiet()
+	}
+
+	_, exp := d.fullPrecision()
+	return compose(false, uint128{1, 0}, exp)
+}
+
+// fullPrecision returns the significand and biased exponent of |d| scaled to
+// the smallest possible exponent, so that the significand is at least
+// minFullSig unless the minimum exponent is reached.
+func (d Decimal) fullPrecision() (sig uint128, exp int16) {
+	sig, exp = d.decompose()
+
+	if sig == (uint128{}) {
+		return sig, 0
+	}
+
+	for sig.cmp(minFullSig) < 0 && exp > 0 {
+		sig = sig.mul64(10)
+		exp--
+	}
+
+	return sig, exp
+}
+
+// ordinal returns the position of |d| in the ordered sequence of distinct
+// representable values, where 0 has the ordinal 0 and +Inf the ordinal one
+// greater than the largest finite value.
+func (d Decimal) ordinal() uint128 {
+	if d.isInf() {
+		ord := fullSigCount.mul64(maxBiasedExponent).add(maxSig)
+		return uint128{ord[0], ord[1]}.add64(1)
+	}
+
+	sig, exp := d.fullPrecision()
+	ord := fullSigCount.mul64(uint64(exp)).add(sig)
+
+	return uint128{ord[0], ord[1]}
+}
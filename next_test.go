@@ -0,0 +1,125 @@
+package decimal128
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestDecimalNextDown(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var res Decimal
+
+	for r.scan("nextdown(%v) = %v\n", &val, &res) {
+		next := val.NextDown()
+
+		if !resultEqual(next, res) {
+			t.Errorf("%v.NextDown() = %v, want %v", val, next, res)
+		}
+	}
+}
+
+func TestDecimalNextToward(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var lhs Decimal
+	var rhs Decimal
+	var res Decimal
+
+	for r.scan("nexttoward(%v, %v) = %v\n", &lhs, &rhs, &res) {
+		next := lhs.NextToward(rhs)
+
+		if !resultEqual(next, res) {
+			t.Errorf("%v.NextToward(%v) = %v, want %v", lhs, rhs, next, res)
+		}
+	}
+}
+
+func TestDecimalNextUp(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var res Decimal
+
+	for r.scan("nextup(%v) = %v\n", &val, &res) {
+		next := val.NextUp()
+
+		if !resultEqual(next, res) {
+			t.Errorf("%v.NextUp() = %v, want %v", val, next, res)
+		}
+	}
+}
+
+func TestDecimalUlp(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var res Decimal
+
+	for r.scan("ulp(%v) = %v\n", &val, &res) {
+		ulp := val.Ulp()
+
+		if !resultEqual(ulp, res) {
+			t.Errorf("%v.Ulp() = %v, want %v", val, ulp, res)
+		}
+	}
+}
+
+func TestULPDistance(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var lhs Decimal
+	var rhs Decimal
+	var res big.Int
+
+	for r.scan("ulpdistance(%v, %v) = %v\n", &lhs, &rhs, &res) {
+		dist := ULPDistance(lhs, rhs)
+
+		if dist == nil || dist.Cmp(&res) != 0 {
+			t.Errorf("ULPDistance(%v, %v) = %v, want %v", lhs, rhs, dist, &res)
+		}
+	}
+
+	for _, val := range []Decimal{NaN(), MustParse("1")} {
+		if dist := ULPDistance(NaN(), val); dist != nil {
+			t.Errorf("ULPDistance(NaN, %v) = %v, want <nil>", val, dist)
+		}
+
+		if dist := ULPDistance(val, NaN()); dist != nil {
+			t.Errorf("ULPDistance(%v, NaN) = %v, want <nil>", val, dist)
+		}
+	}
+
+	initDecimalValues()
+
+	one := big.NewInt(1)
+
+	for _, val := range decimalValues {
+		d := val.Decimal()
+		if d.IsNaN() || d.Equal(Inf(1)) {
+			continue
+		}
+
+		next := d.NextUp()
+
+		if dist := ULPDistance(d, next); dist.Cmp(one) != 0 {
+			t.Errorf("ULPDistance(%v, %v) = %v, want 1", d, next, dist)
+		}
+	}
+}
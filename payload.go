@@ -22,8 +22,22 @@ const (
 	payloadOpQuoRem
 	payloadOpSqrt
 	payloadOpSub
+	payloadOpQuantize
+	payloadOpRescale
+	payloadOpFMA
+	payloadOpRemainder
+	payloadOpModf
+	payloadOpRoundToIncrement
+	payloadOpCos
+	payloadOpSin
+	payloadOpTan
 )
 
+// payloadDiagnostic is set in the payload of every NaN generated by this
+// package, to tell the diagnostic payloads described by [Payload] apart from
+// payloads provided by the user, such as the 123 in "NaN123".
+const payloadDiagnostic = 0x0000_2000_0000_0000
+
 const (
 	payloadValPosZero Payload = iota + 1
 	payloadValNegZero
@@ -97,6 +111,24 @@ func (p Payload) String() string {
 		return "Sqrt(" + p.argString(8) + ")"
 	case payloadOpSub:
 		return "Sub(" + p.argString(8) + ", " + p.argString(16) + ")"
+	case payloadOpQuantize:
+		return "Quantize(" + p.argString(8) + ", " + p.argString(16) + ")"
+	case payloadOpRescale:
+		return "Rescale(" + p.argString(8) + ")"
+	case payloadOpFMA:
+		return "FMA(" + p.argString(8) + ", " + p.argString(16) + ")"
+	case payloadOpRemainder:
+		return "Remainder(" + p.argString(8) + ", " + p.argString(16) + ")"
+	case payloadOpModf:
+		return "Modf(" + p.argString(8) + ")"
+	case payloadOpRoundToIncrement:
+		return "RoundToIncrement(" + p.argString(8) + ", " + p.argString(16) + ")"
+	case payloadOpCos:
+		return "Cos(" + p.argString(8) + ")"
+	case payloadOpSin:
+		return "Sin(" + p.argString(8) + ")"
+	case payloadOpTan:
+		return "Tan(" + p.argString(8) + ")"
 	default:
 		return fmt.Sprintf("Payload(%d)", uint64(p))
 	}
@@ -120,3 +152,34 @@ func (p Payload) argString(offset int) string {
 		return "Unknown"
 	}
 }
+
+// nanPayload returns the payload of the NaN d as an integer, or 0 if it holds
+// a diagnostic payload generated by an operation in this package.
+func (d Decimal) nanPayload() uint128 {
+	payload := uint128{d.lo, d.hi & 0x0000_3fff_ffff_ffff}
+
+	if payload[1] == payloadDiagnostic {
+		return uint128{}
+	}
+
+	return payload
+}
+
+// payloadVal returns the payload value describing d as an operand.
+func (d Decimal) payloadVal() Payload {
+	var val Payload
+	switch {
+	case d.isInf():
+		val = payloadValPosInfinite
+	case d.IsZero():
+		val = payloadValPosZero
+	default:
+		val = payloadValPosFinite
+	}
+
+	if d.Signbit() {
+		val++
+	}
+
+	return val
+}
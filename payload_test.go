@@ -127,4 +127,59 @@ func TestDecimalPayload(t *testing.T) {
 	if s := d.Payload().String(); s != "Sub(-Infinite, -Infinite)" {
 		t.Errorf("-Inf.Sub(-Inf).Payload() = %s, want Sub(-Infinite, -Infinite)", s)
 	}
+
+	d, _ = inf(false).Quantize(New(1, 0), ToNearestEven)
+	if s := d.Payload().String(); s != "Quantize(Infinite, Finite)" {
+		t.Errorf("Inf.Quantize(1).Payload() = %s, want Quantize(Infinite, Finite)", s)
+	}
+
+	d, _ = New(-1, 0).Rescale(maxUnbiasedExponent+1, ToNearestEven)
+	if s := d.Payload().String(); s != "Rescale(-Finite)" {
+		t.Errorf("-1.Rescale(6112).Payload() = %s, want Rescale(-Finite)", s)
+	}
+
+	d = FMA(inf(false), zero(true), New(1, 0))
+	if s := d.Payload().String(); s != "FMA(Infinite, -Zero)" {
+		t.Errorf("FMA(Inf, -0, 1).Payload() = %s, want FMA(Infinite, -Zero)", s)
+	}
+
+	d = Remainder(inf(true), New(1, 0))
+	if s := d.Payload().String(); s != "Remainder(-Infinite, Finite)" {
+		t.Errorf("Remainder(-Inf, 1).Payload() = %s, want Remainder(-Infinite, Finite)", s)
+	}
+
+	d = Remainder(New(1, 0), zero(false))
+	if s := d.Payload().String(); s != "Remainder(Finite, Zero)" {
+		t.Errorf("Remainder(1, 0).Payload() = %s, want Remainder(Finite, Zero)", s)
+	}
+
+	_, d = Modf(inf(true))
+	if s := d.Payload().String(); s != "Modf(-Infinite)" {
+		t.Errorf("Modf(-Inf).Payload() = %s, want Modf(-Infinite)", s)
+	}
+
+	d = New(1, 0).RoundToIncrement(zero(true), ToNearestEven)
+	if s := d.Payload().String(); s != "RoundToIncrement(Finite, -Zero)" {
+		t.Errorf("1.RoundToIncrement(-0).Payload() = %s, want RoundToIncrement(Finite, -Zero)", s)
+	}
+
+	d = Sin(inf(false))
+	if s := d.Payload().String(); s != "Sin(Infinite)" {
+		t.Errorf("Sin(Inf).Payload() = %s, want Sin(Infinite)", s)
+	}
+
+	d = Cos(inf(true))
+	if s := d.Payload().String(); s != "Cos(-Infinite)" {
+		t.Errorf("Cos(-Inf).Payload() = %s, want Cos(-Infinite)", s)
+	}
+
+	d = Tan(inf(false))
+	if s := d.Payload().String(); s != "Tan(Infinite)" {
+		t.Errorf("Tan(Inf).Payload() = %s, want Tan(Infinite)", s)
+	}
+
+	_, d = Sincos(inf(true))
+	if s := d.Payload().String(); s != "Cos(-Infinite)" {
+		t.Errorf("Sincos(-Inf).Payload() = (_, %s), want (_, Cos(-Infinite))", s)
+	}
 }
@@ -0,0 +1,11 @@
+//go:build go1.22
+
+package decimal128
+
+import "math/rand/v2"
+
+// randUint64 returns a random number from the top-level functions of
+// math/rand/v2, which are safe for concurrent use.
+func randUint64() uint64 {
+	return rand.Uint64()
+}
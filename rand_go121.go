@@ -0,0 +1,11 @@
+//go:build !go1.22
+
+package decimal128
+
+import "math/rand"
+
+// randUint64 returns a random number from the top-level functions of
+// math/rand, as math/rand/v2 requires Go 1.22.
+func randUint64() uint64 {
+	return rand.Uint64()
+}
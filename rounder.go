@@ -0,0 +1,279 @@
+package decimal128
+
+import (
+	"errors"
+	"math"
+	"sync/atomic"
+)
+
+// ErrDefaultRoundingModeFrozen is returned by [SetDefaultRoundingMode] once
+// [FreezeDefaultRoundingMode] has been called.
+var ErrDefaultRoundingModeFrozen = errors.New("decimal128: default rounding mode is frozen")
+
+// defaultRounding holds the default rounding mode in its low 8 bits, together
+// with the defaultRoundingSet and defaultRoundingFrozen flags. Until the
+// default is set or frozen, DefaultRoundingMode is used instead.
+var defaultRounding atomic.Uint32
+
+const (
+	defaultRoundingSet    = 1 << 8
+	defaultRoundingFrozen = 1 << 9
+)
+
+// SetDefaultRoundingMode sets the rounding mode used by functions and methods
+// that do not take a rounding mode, such as [Decimal.Add] and [Parse]. It is
+// safe to call concurrently with arithmetic in other goroutines, but is meant
+// to be called once while a program starts.
+//
+// SetDefaultRoundingMode returns an error if mode is not a valid rounding
+// mode, or [ErrDefaultRoundingModeFrozen] if [FreezeDefaultRoundingMode] has
+// been called.
+func SetDefaultRoundingMode(mode RoundingMode) error {
+	if mode > Stochastic {
+		return errors.New("decimal128: invalid rounding mode " + mode.String())
+	}
+
+	for {
+		old := defaultRounding.Load()
+
+		if old&defaultRoundingFrozen != 0 {
+			return ErrDefaultRoundingModeFrozen
+		}
+
+		if defaultRounding.CompareAndSwap(old, uint32(mode)|defaultRoundingSet) {
+			return nil
+		}
+	}
+}
+
+// FreezeDefaultRoundingMode prevents any further changes to the default
+// rounding mode through [SetDefaultRoundingMode]. A program can call it after
+// its configuration has been applied, so that no library it uses can change
+// how the rest of the program rounds. If the default has not been set, the
+// current value of [DefaultRoundingMode] is frozen.
+func FreezeDefaultRoundingMode() {
+	for {
+		old := defaultRounding.Load()
+
+		mode := old
+		if old&defaultRoundingSet == 0 {
+			mode = uint32(DefaultRoundingMode) | defaultRoundingSet
+		}
+
+		if defaultRounding.CompareAndSwap(old, mode|defaultRoundingFrozen) {
+			return
+		}
+	}
+}
+
+// DefaultRounder returns a Rounder using the current default rounding mode.
+func DefaultRounder() Rounder {
+	return Rounder{Mode: defaultRoundingMode()}
+}
+
+// defaultRoundingMode returns the rounding mode set by SetDefaultRoundingMode,
+// or DefaultRoundingMode if the default has never been set or frozen.
+func defaultRoundingMode() RoundingMode {
+	mode := defaultRounding.Load()
+	if mode&defaultRoundingSet == 0 {
+		return DefaultRoundingMode
+	}
+
+	return RoundingMode(mode)
+}
+
+// Rounder performs arithmetic rounded using a fixed rounding mode. Unlike the
+// default rounding mode, which is shared by the whole program, a Rounder is a
+// value that is passed to the code using it, so components that need
+// different rounding modes do not affect each other. A Rounder is safe for
+// concurrent use by multiple goroutines if its Source is.
+//
+// The zero value rounds using [ToNearestEven].
+type Rounder struct {
+	// Mode is the rounding mode used by operations.
+	Mode RoundingMode
+
+	// Source is the source of the random numbers drawn when Mode is
+	// [Stochastic]. If it is nil, the top-level functions of math/rand/v2 are
+	// used.
+	Source RandomSource
+}
+
+// Add adds x and y and returns the result.
+func (r Rounder) Add(x, y Decimal) Decimal {
+	if x.isSpecial() || y.isSpecial() {
+		return x.AddWithMode(y, r.Mode)
+	}
+
+	res, _ := x.add(y, r.rounding(), false)
+	return res
+}
+
+// Cos returns the cosine of d, where d is in radians.
+func (r Rounder) Cos(d Decimal) Decimal {
+	res, _ := d.cos(r.rounding())
+	return res
+}
+
+// Exp returns e**d, the base-e exponential of d.
+func (r Rounder) Exp(d Decimal) Decimal {
+	res, _ := d.exp(r.rounding())
+	return res
+}
+
+// Exp10 returns 10**d, the base-10 exponential of d.
+func (r Rounder) Exp10(d Decimal) Decimal {
+	res, _ := d.exp10(r.rounding())
+	return res
+}
+
+// Exp2 returns 2**d, the base-2 exponential of d.
+func (r Rounder) Exp2(d Decimal) Decimal {
+	res, _ := d.exp2(r.rounding())
+	return res
+}
+
+// FMA returns x*y+z, computed with only one rounding.
+func (r Rounder) FMA(x, y, z Decimal) Decimal {
+	res, _ := x.fma(y, z, r.rounding())
+	return res
+}
+
+// FromFloat32 converts f into a Decimal.
+func (r Rounder) FromFloat32(f float32) Decimal {
+	if math.IsNaN(float64(f)) {
+		return nan(payloadOpFromFloat32, 0, 0)
+	}
+
+	return fromFloat64(float64(f), r.rounding())
+}
+
+// FromFloat64 converts f into a Decimal.
+func (r Rounder) FromFloat64(f float64) Decimal {
+	return fromFloat64(f, r.rounding())
+}
+
+// Log returns the natural logarithm of d.
+func (r Rounder) Log(d Decimal) Decimal {
+	res, _ := d.log(r.rounding())
+	return res
+}
+
+// Log10 returns the decimal logarithm of d.
+func (r Rounder) Log10(d Decimal) Decimal {
+	res, _ := d.log10(r.rounding())
+	return res
+}
+
+// Log2 returns the binary logarithm of d.
+func (r Rounder) Log2(d Decimal) Decimal {
+	res, _ := d.log2(r.rounding())
+	return res
+}
+
+// Mul multiplies x and y and returns the result.
+func (r Rounder) Mul(x, y Decimal) Decimal {
+	res, _ := x.mul(y, r.rounding())
+	return res
+}
+
+// New returns a new Decimal with the provided significand and exponent.
+func (r Rounder) New(sig int64, exp int) Decimal {
+	return newDecimal(sig, exp, r.rounding())
+}
+
+// Parse parses a Decimal value from the string provided. See [Parse] for
+// details.
+func (r Rounder) Parse(s string) (Decimal, error) {
+	return parse(s, payloadOpParse, r.rounding())
+}
+
+// Pow raises x to the power of y and returns the result.
+func (r Rounder) Pow(x, y Decimal) Decimal {
+	res, _ := x.pow(y, r.rounding())
+	return res
+}
+
+// PowInt raises d to the integer power n and returns the result.
+func (r Rounder) PowInt(d Decimal, n int64) Decimal {
+	res, _ := d.pow(FromInt64(n), r.rounding())
+	return res
+}
+
+// Quantize returns d rounded to have the same exponent as pattern. See
+// [Decimal.Quantize] for details.
+func (r Rounder) Quantize(d, pattern Decimal) (Decimal, error) {
+	return d.quantize(pattern, r.rounding())
+}
+
+// Quo divides x by y and returns the result.
+func (r Rounder) Quo(x, y Decimal) Decimal {
+	res, _ := x.quo(y, r.rounding())
+	return res
+}
+
+// QuoRem divides x by y and returns the result as an integer quotient and a
+// remainder.
+func (r Rounder) QuoRem(x, y Decimal) (Decimal, Decimal) {
+	quo, rem, _ := x.quoRem(y, r.rounding())
+	return quo, rem
+}
+
+// Rescale returns d rounded to have the exponent exp. See [Decimal.Rescale]
+// for details.
+func (r Rounder) Rescale(d Decimal, exp int) (Decimal, error) {
+	return d.rescaleExp(exp, r.rounding())
+}
+
+// Round rounds d to the specified number of decimal places.
+func (r Rounder) Round(d Decimal, dp int) Decimal {
+	return d.round(dp, r.rounding())
+}
+
+// RoundSig rounds d to at most n significant digits.
+func (r Rounder) RoundSig(d Decimal, n int) Decimal {
+	return d.roundSig(n, r.rounding())
+}
+
+// RoundToIncrement rounds d to a multiple of inc.
+func (r Rounder) RoundToIncrement(d, inc Decimal) Decimal {
+	return d.roundToIncrement(inc, r.rounding())
+}
+
+// Sin returns the sine of d, where d is in radians.
+func (r Rounder) Sin(d Decimal) Decimal {
+	res, _ := d.sin(r.rounding())
+	return res
+}
+
+// Sincos returns r.Sin(d), r.Cos(d). See [Sincos] for details.
+func (r Rounder) Sincos(d Decimal) (sin, cos Decimal) {
+	return d.sincos(r.rounding())
+}
+
+// Sqrt returns the square root of d.
+func (r Rounder) Sqrt(d Decimal) Decimal {
+	res, _ := d.sqrt(r.rounding())
+	return res
+}
+
+// Sub subtracts y from x and returns the result.
+func (r Rounder) Sub(x, y Decimal) Decimal {
+	if x.isSpecial() || y.isSpecial() {
+		return x.SubWithMode(y, r.Mode)
+	}
+
+	res, _ := x.add(y, r.rounding(), true)
+	return res
+}
+
+// Tan returns the tangent of d, where d is in radians.
+func (r Rounder) Tan(d Decimal) Decimal {
+	res, _ := d.tan(r.rounding())
+	return res
+}
+
+// rounding returns the rounding mode and source of r.
+func (r Rounder) rounding() rounding {
+	return rounding{r.Mode, r.Source}
+}
@@ -0,0 +1,170 @@
+package decimal128
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRounder(t *testing.T) {
+	t.Parallel()
+
+	x := MustParse("2.675")
+	y := MustParse("-0.03")
+
+	for _, mode := range roundingModes {
+		r := Rounder{Mode: mode}
+
+		if res, want := r.Add(x, y), x.AddWithMode(y, mode); !resultEqual(res, want) {
+			t.Errorf("Rounder{%v}.Add(%v, %v) = %v, want %v", mode, x, y, res, want)
+		}
+
+		if res, want := r.Sub(x, y), x.SubWithMode(y, mode); !resultEqual(res, want) {
+			t.Errorf("Rounder{%v}.Sub(%v, %v) = %v, want %v", mode, x, y, res, want)
+		}
+
+		if res, want := r.Mul(x, y), x.MulWithMode(y, mode); !resultEqual(res, want) {
+			t.Errorf("Rounder{%v}.Mul(%v, %v) = %v, want %v", mode, x, y, res, want)
+		}
+
+		if res, want := r.Quo(x, y), x.QuoWithMode(y, mode); !resultEqual(res, want) {
+			t.Errorf("Rounder{%v}.Quo(%v, %v) = %v, want %v", mode, x, y, res, want)
+		}
+
+		if res, want := r.FMA(x, y, x), FMAWithMode(x, y, x, mode); !resultEqual(res, want) {
+			t.Errorf("Rounder{%v}.FMA(%v, %v, %v) = %v, want %v", mode, x, y, x, res, want)
+		}
+
+		if res, want := r.Round(x, 2), x.Round(2, mode); !resultEqual(res, want) {
+			t.Errorf("Rounder{%v}.Round(%v, 2) = %v, want %v", mode, x, res, want)
+		}
+
+		if res, want := r.RoundToIncrement(x, MustParse("0.05")), x.RoundToIncrement(MustParse("0.05"), mode); !resultEqual(res, want) {
+			t.Errorf("Rounder{%v}.RoundToIncrement(%v, 0.05) = %v, want %v", mode, x, res, want)
+		}
+
+		if res, want := r.PowInt(x, -3), PowIntWithMode(x, -3, mode); !resultEqual(res, want) {
+			t.Errorf("Rounder{%v}.PowInt(%v, -3) = %v, want %v", mode, x, res, want)
+		}
+
+		want, _ := x.exp(rounding{RoundingMode: mode})
+		if res := r.Exp(x); !resultEqual(res, want) {
+			t.Errorf("Rounder{%v}.Exp(%v) = %v, want %v", mode, x, res, want)
+		}
+
+		want, _ = x.sqrt(rounding{RoundingMode: mode})
+		if res := r.Sqrt(x); !resultEqual(res, want) {
+			t.Errorf("Rounder{%v}.Sqrt(%v) = %v, want %v", mode, x, res, want)
+		}
+
+		if res, want := r.Sin(x), SinWithMode(x, mode); !resultEqual(res, want) {
+			t.Errorf("Rounder{%v}.Sin(%v) = %v, want %v", mode, x, res, want)
+		}
+
+		if res, want := r.Cos(x), CosWithMode(x, mode); !resultEqual(res, want) {
+			t.Errorf("Rounder{%v}.Cos(%v) = %v, want %v", mode, x, res, want)
+		}
+
+		if res, want := r.Tan(x), TanWithMode(x, mode); !resultEqual(res, want) {
+			t.Errorf("Rounder{%v}.Tan(%v) = %v, want %v", mode, x, res, want)
+		}
+
+		if sin, cos := r.Sincos(x); !resultEqual(sin, SinWithMode(x, mode)) || !resultEqual(cos, CosWithMode(x, mode)) {
+			t.Errorf("Rounder{%v}.Sincos(%v) = %v, %v, want %v, %v", mode, x, sin, cos, SinWithMode(x, mode), CosWithMode(x, mode))
+		}
+
+		want = New(1, 0).QuoWithMode(New(3, 0), mode)
+		if res, err := r.Parse("0.3333333333333333333333333333333333333333"); err != nil || !resultEqual(res, want) {
+			t.Errorf("Rounder{%v}.Parse(0.3333333333333333333333333333333333333333) = (%v, %v), want (%v, <nil>)", mode, res, err, want)
+		}
+
+		if res, want := r.New(-12345, -3), MustParse("-12.345"); !resultEqual(res, want) || !res.SameQuantum(want) {
+			t.Errorf("Rounder{%v}.New(-12345, -3) = %v, want %v", mode, res, want)
+		}
+
+		want, _ = r.Parse("0.1000000000000000055511151231257827021181583404541015625")
+		if res := r.FromFloat64(0.1); !resultEqual(res, want) {
+			t.Errorf("Rounder{%v}.FromFloat64(0.1) = %v, want %v", mode, res, want)
+		}
+
+		want, _ = r.Parse("0.100000001490116119384765625")
+		if res := r.FromFloat32(0.1); !resultEqual(res, want) {
+			t.Errorf("Rounder{%v}.FromFloat32(0.1) = %v, want %v", mode, res, want)
+		}
+	}
+
+	if res := (Rounder{}).Quo(New(2, 0), New(3, 0)); !res.Equal(New(2, 0).QuoWithMode(New(3, 0), ToNearestEven)) {
+		t.Errorf("Rounder{}.Quo(2, 3) = %v, want it rounded using ToNearestEven", res)
+	}
+}
+
+func TestSetDefaultRoundingMode(t *testing.T) {
+	t.Cleanup(func() {
+		defaultRounding.Store(0)
+	})
+
+	third := New(1, 0).QuoWithMode(New(3, 0), ToZero)
+
+	if err := SetDefaultRoundingMode(AwayFromZero); err != nil {
+		t.Fatalf("SetDefaultRoundingMode(AwayFromZero) = %v, want nil", err)
+	}
+
+	if res := New(1, 0).Quo(New(3, 0)); !res.Equal(third.NextUp()) {
+		t.Errorf("1.Quo(3) = %v, want %v", res, third.NextUp())
+	}
+
+	if err := SetDefaultRoundingMode(ToZero); err != nil {
+		t.Fatalf("SetDefaultRoundingMode(ToZero) = %v, want nil", err)
+	}
+
+	if res := New(1, 0).Quo(New(3, 0)); !res.Equal(third) {
+		t.Errorf("1.Quo(3) = %v, want %v", res, third)
+	}
+
+	if res := DefaultRounder(); res.Mode != ToZero {
+		t.Errorf("DefaultRounder().Mode = %v, want ToZero", res.Mode)
+	}
+
+	if err := SetDefaultRoundingMode(RoundingMode(200)); err == nil {
+		t.Errorf("SetDefaultRoundingMode(RoundingMode(200)) = nil, want error")
+	}
+
+	FreezeDefaultRoundingMode()
+
+	if err := SetDefaultRoundingMode(ToNearestEven); !errors.Is(err, ErrDefaultRoundingModeFrozen) {
+		t.Errorf("SetDefaultRoundingMode(ToNearestEven) = %v, want %v", err, ErrDefaultRoundingModeFrozen)
+	}
+
+	if res := DefaultRounder(); res.Mode != ToZero {
+		t.Errorf("DefaultRounder().Mode = %v, want ToZero", res.Mode)
+	}
+
+	defaultRounding.Store(0)
+
+	old := DefaultRoundingMode
+	DefaultRoundingMode = AwayFromZero
+	defer func() { DefaultRoundingMode = old }()
+
+	if res := New(1, 0).Quo(New(3, 0)); !res.Equal(third.NextUp()) {
+		t.Errorf("1.Quo(3) with DefaultRoundingMode = AwayFromZero = %v, want %v", res, third.NextUp())
+	}
+
+	if res := DefaultRounder(); res.Mode != AwayFromZero {
+		t.Errorf("DefaultRounder().Mode = %v, want AwayFromZero", res.Mode)
+	}
+
+	if err := SetDefaultRoundingMode(ToZero); err != nil {
+		t.Fatalf("SetDefaultRoundingMode(ToZero) = %v, want nil", err)
+	}
+
+	if res := New(1, 0).Quo(New(3, 0)); !res.Equal(third) {
+		t.Errorf("1.Quo(3) after SetDefaultRoundingMode(ToZero) = %v, want %v", res, third)
+	}
+
+	defaultRounding.Store(0)
+	FreezeDefaultRoundingMode()
+	DefaultRoundingMode = ToZero
+
+	if res := DefaultRounder(); res.Mode != AwayFromZero {
+		t.Errorf("DefaultRounder().Mode after FreezeDefaultRoundingMode = %v, want AwayFromZero", res.Mode)
+	}
+}
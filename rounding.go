@@ -29,6 +29,50 @@ func Round(d Decimal) Decimal {
 	return d.Round(0, ToNearestAway)
 }
 
+// Trunc returns the integer value of d, with any digits after the decimal
+// point removed.
+//
+// Trunc is equivalent to:
+//
+//	d.Trunc(0)
+func Trunc(d Decimal) Decimal {
+	return d.Trunc(0)
+}
+
+// Modf returns integer and fractional parts of d that sum to d. Both parts
+// have the same sign as d, so Modf(-3.5) returns -3 and -0.5, and the
+// fractional part keeps the exponent of d, so Modf(1.250) returns 1 and
+// 0.250. No rounding takes place.
+//
+// Special cases are:
+//
+//	Modf(±Inf) = ±Inf, NaN
+//	Modf(NaN) = NaN, NaN
+func Modf(d Decimal) (int, frac Decimal) {
+	if d.isSpecial() {
+		if d.isInf() {
+			return d, nan(payloadOpModf, d.payloadVal(), 0)
+		}
+
+		return d.quiet(), d.quiet()
+	}
+
+	neg := d.Signbit()
+	sig, exp := d.decompose()
+
+	if exp >= exponentBias {
+		return d, zero(neg)
+	}
+
+	shift := exponentBias - exp
+	if shift > maxDigits {
+		return zero(neg), d
+	}
+
+	_, rem := sig.div(uint128PowersOf10[shift])
+	return d.Trunc(0), compose(neg, rem, exp)
+}
+
 // Ceil returns the least Decimal value greater than or equal to d that has no
 // digits after the specified number of decimal places.
 //
@@ -40,7 +84,7 @@ func Round(d Decimal) Decimal {
 // NaN and infinity values are left untouched.
 func (d Decimal) Ceil(dp int) Decimal {
 	if d.isSpecial() {
-		return d
+		return d.quiet()
 	}
 
 	sig, exp := d.decompose()
@@ -110,6 +154,14 @@ func (d Decimal) Ceil(dp int) Decimal {
 	return compose(neg, sig, exp)
 }
 
+// CeilTo returns the least multiple of inc that is greater than or equal to
+// d. It is equivalent to:
+//
+//	d.RoundToIncrement(inc, decimal128.ToPositiveInf)
+func (d Decimal) CeilTo(inc Decimal) Decimal {
+	return d.RoundToIncrement(inc, ToPositiveInf)
+}
+
 // Floor returns the greatest Decimal value less than or equal to d that has no
 // digits after the specified number of decimal places.
 //
@@ -121,7 +173,7 @@ func (d Decimal) Ceil(dp int) Decimal {
 // NaN and infinity values are left untouched.
 func (d Decimal) Floor(dp int) Decimal {
 	if d.isSpecial() {
-		return d
+		return d.quiet()
 	}
 
 	sig, exp := d.decompose()
@@ -191,6 +243,14 @@ func (d Decimal) Floor(dp int) Decimal {
 	return compose(neg, sig, exp)
 }
 
+// FloorTo returns the greatest multiple of inc that is less than or equal to
+// d. It is equivalent to:
+//
+//	d.RoundToIncrement(inc, decimal128.ToNegativeInf)
+func (d Decimal) FloorTo(inc Decimal) Decimal {
+	return d.RoundToIncrement(inc, ToNegativeInf)
+}
+
 // Round rounds (or quantises) a Decimal value to the specified number of
 // decimal places using the rounding mode provided.
 //
@@ -202,8 +262,12 @@ func (d Decimal) Floor(dp int) Decimal {
 //
 // NaN and infinity values are left untouched.
 func (d Decimal) Round(dp int, mode RoundingMode) Decimal {
+	return d.round(dp, rounding{RoundingMode: mode})
+}
+
+func (d Decimal) round(dp int, mode rounding) Decimal {
 	if d.isSpecial() {
-		return d
+		return d.quiet()
 	}
 
 	sig, exp := d.decompose()
@@ -224,7 +288,7 @@ func (d Decimal) Round(dp int, mode RoundingMode) Decimal {
 	}
 
 	var trunc int8
-	var digit uint64
+	var digit, frac uint64
 
 	for iexp < dp {
 		if digit != 0 {
@@ -232,6 +296,7 @@ func (d Decimal) Round(dp int, mode RoundingMode) Decimal {
 		}
 
 		sig, digit = sig.div10()
+		frac = mode.shiftFrac(frac, trunc, digit, 10)
 
 		if sig == (uint128{}) && digit == 0 {
 			return zero(d.Signbit())
@@ -241,7 +306,7 @@ func (d Decimal) Round(dp int, mode RoundingMode) Decimal {
 	}
 
 	neg := d.Signbit()
-	sig, exp = mode.round(false, neg, sig, int16(iexp), trunc, digit)
+	sig, exp, _ = mode.round(false, neg, sig, int16(iexp), trunc, digit, frac)
 
 	if exp > maxBiasedExponent {
 		return inf(neg)
@@ -250,19 +315,403 @@ func (d Decimal) Round(dp int, mode RoundingMode) Decimal {
 	return compose(neg, sig, exp)
 }
 
+// RoundSig rounds d to at most n significant digits using the rounding mode
+// provided. Values that have n or fewer digits in their coefficient are
+// returned unchanged, and values of n less than 1 are treated as 1.
+//
+// NaN and infinity values are left untouched.
+func (d Decimal) RoundSig(n int, mode RoundingMode) Decimal {
+	return d.roundSig(n, rounding{RoundingMode: mode})
+}
+
+func (d Decimal) roundSig(n int, mode rounding) Decimal {
+	if d.isSpecial() {
+		return d.quiet()
+	}
+
+	sig, exp := d.decompose()
+
+	if n < 1 {
+		n = 1
+	}
+
+	shift := sig.log10() + 1 - n
+	if sig == (uint128{}) || shift <= 0 {
+		return d
+	}
+
+	var trunc int8
+	var digit, frac uint64
+
+	for i := 0; i < shift; i++ {
+		if digit != 0 {
+			trunc = 1
+		}
+
+		sig, digit = sig.div10()
+		frac = mode.shiftFrac(frac, trunc, digit, 10)
+	}
+
+	neg := d.Signbit()
+	sig, exp, _ = mode.round(false, neg, sig, exp+int16(shift), trunc, digit, frac)
+
+	if sig == uint128PowersOf10[n] {
+		sig = uint128PowersOf10[n-1]
+		exp++
+	}
+
+	sig, iexp, ok := clampExponent(sig, int(exp))
+	if !ok {
+		return inf(neg)
+	}
+
+	return compose(neg, sig, int16(iexp))
+}
+
+// RoundToIncrement rounds d to a multiple of inc using the rounding mode
+// provided, with ties resolved as if the quotient of d and inc were rounded to
+// an integer. This can be used for cash rounding to 0.05, or to round a price
+// to a tick size such as 0.25 or 0.03125. The sign of inc is ignored, and the
+// result has the exponent of inc unless its coefficient would be too large to
+// represent, in which case it is rounded like the result of [Decimal.Add].
+//
+// Special cases are:
+//
+//	RoundToIncrement(±Inf, inc) = ±Inf
+//	RoundToIncrement(d, ±Inf) = NaN
+//	RoundToIncrement(d, ±0) = NaN
+//	RoundToIncrement(d, NaN) = RoundToIncrement(NaN, inc) = NaN
+func (d Decimal) RoundToIncrement(inc Decimal, mode RoundingMode) Decimal {
+	return d.roundToIncrement(inc, rounding{RoundingMode: mode})
+}
+
+func (d Decimal) roundToIncrement(inc Decimal, mode rounding) Decimal {
+	if d.isSpecial() || inc.isSpecial() {
+		if d.IsNaN() || inc.IsNaN() {
+			return propagateNaN(d, inc)
+		}
+
+		if !inc.isInf() {
+			return d
+		}
+
+		return nan(payloadOpRoundToIncrement, d.payloadVal(), inc.payloadVal())
+	}
+
+	if inc.IsZero() {
+		return nan(payloadOpRoundToIncrement, d.payloadVal(), inc.payloadVal())
+	}
+
+	inc = Abs(inc)
+	quo, rem, _ := d.quoRem(inc, rounding{RoundingMode: ToZero})
+
+	sig, exp := quo.decompose()
+	if sig == (uint128{}) {
+		exp = exponentBias
+	}
+
+	for exp > exponentBias && sig[1] <= 0x0002_7fff_ffff_ffff/10 {
+		sig = sig.mul64(10)
+		exp--
+	}
+
+	var trunc int8
+	var digit, frac uint64
+
+	if !rem.IsZero() {
+		// Compare twice the remainder with the increment, which decides
+		// whether the quotient is rounded like a trailing digit of 0, 5, or a
+		// value in between.
+		rSig, rExp := rem.decompose()
+		iSig, iExp := inc.decompose()
+
+		r := uint192{rSig[0], rSig[1], 0}.lsh(1)
+		i := uint192{iSig[0], iSig[1], 0}
+
+		for ; rExp > iExp; rExp-- {
+			r = r.mul64(10)
+		}
+
+		for iExp > rExp && i.cmp(r) <= 0 {
+			i = i.mul64(10)
+			iExp--
+		}
+
+		switch r.cmp(i) {
+		case -1:
+			trunc = 1
+		case 0:
+			digit = 5
+		case 1:
+			trunc = 1
+			digit = 5
+		}
+
+		frac = mode.scaledFrac(r, i.lsh(1), iExp-rExp)
+	}
+
+	neg := d.Signbit()
+
+	_, last := sig.div10()
+	up, _, _ := mode.round(false, neg, uint128{last, 0}, 0, trunc, digit, frac)
+	adjust := up[0] != last
+
+	if exp > exponentBias || sig[1] > 0x0002_7fff_ffff_ffff/10 {
+		// The multiple has more digits than fit into a Decimal, so it is
+		// computed from the remainder and rounded.
+		if !adjust {
+			res, _ := d.add(rem, mode, true)
+			return res
+		}
+
+		delta := inc.Sub(Abs(rem))
+		if neg {
+			delta = delta.Neg()
+		}
+
+		res, _ := d.add(delta, mode, false)
+		return res
+	}
+
+	if adjust {
+		sig = sig.add64(1)
+	}
+
+	res, _ := compose(neg, sig, exponentBias).mul(inc, mode)
+	return res
+}
+
+// Trunc returns d with all digits after the specified number of decimal
+// places removed, which rounds it towards zero. The sign of d is kept, even
+// when the result is zero.
+//
+// The value of dp affects how many digits after the decimal point the Decimal
+// would have if it were printed in decimal notation (for example, by the '%f'
+// verb in Format). It can be zero to return an integer, and can also be
+// negative to remove digits before the decimal point.
+//
+// NaN and infinity values are left untouched.
+func (d Decimal) Trunc(dp int) Decimal {
+	if d.isSpecial() {
+		return d.quiet()
+	}
+
+	sig, exp := d.decompose()
+
+	if sig == (uint128{}) {
+		return zero(d.Signbit())
+	}
+
+	dp = dp*-1 + exponentBias
+	iexp := int(exp)
+
+	if iexp >= dp {
+		return d
+	}
+
+	if iexp < dp-maxDigits {
+		return zero(d.Signbit())
+	}
+
+	for iexp < dp {
+		sig, _ = sig.div10()
+
+		if sig == (uint128{}) {
+			return zero(d.Signbit())
+		}
+
+		iexp++
+	}
+
+	if iexp > maxBiasedExponent {
+		return inf(d.Signbit())
+	}
+
+	return compose(d.Signbit(), sig, int16(iexp))
+}
+
+// Frac returns the fractional part of d, with the same sign as d.
+//
+// Frac is equivalent to:
+//
+//	_, frac := decimal128.Modf(d)
+func (d Decimal) Frac() Decimal {
+	_, frac := Modf(d)
+	return frac
+}
+
+// Quantize returns d rounded, using the rounding mode provided, to have the
+// same exponent as pattern. This is the equivalent of the IEEE 754 quantize
+// operation, and is useful to store a value using the exact scale of another,
+// such as the tick size of a price.
+//
+// If the coefficient of the result would need more than 34 digits, or exactly
+// one of d and pattern is infinite, Quantize returns NaN and a
+// *[ConditionError] signalling InvalidOperation. If either value is NaN the
+// result is NaN, and if both are infinite the result is d. A signaling NaN is
+// returned quieted, along with a *[ConditionError] signalling
+// InvalidOperation.
+func (d Decimal) Quantize(pattern Decimal, mode RoundingMode) (Decimal, error) {
+	return d.quantize(pattern, rounding{RoundingMode: mode})
+}
+
+func (d Decimal) quantize(pattern Decimal, mode rounding) (Decimal, error) {
+	if d.isSpecial() || pattern.isSpecial() {
+		if d.IsNaN() || pattern.IsNaN() {
+			if d.isSignaling() || pattern.isSignaling() {
+				return d.quantizeError("Quantize", pattern)
+			}
+
+			return propagateNaN(d, pattern), nil
+		}
+
+		if d.isInf() && pattern.isInf() {
+			return d, nil
+		}
+
+		return d.quantizeError("Quantize", pattern)
+	}
+
+	_, exp := pattern.decompose()
+
+	res, ok := d.rescale(exp, mode)
+	if !ok {
+		return d.quantizeError("Quantize", pattern)
+	}
+
+	return res, nil
+}
+
+// Rescale returns d rounded, using the rounding mode provided, to have the
+// exponent exp. The exponent is that of the coefficient as stored in the
+// Decimal, so a value with exp -2 has exactly two digits after the decimal
+// point.
+//
+// If the coefficient of the result would need more than 34 digits, or exp is
+// outside of the range of a Decimal, Rescale returns NaN and a
+// *[ConditionError] signalling InvalidOperation. NaN and infinity values are
+// left untouched, except for a signaling NaN which is returned quieted along
+// with the same error.
+func (d Decimal) Rescale(exp int, mode RoundingMode) (Decimal, error) {
+	return d.rescaleExp(exp, rounding{RoundingMode: mode})
+}
+
+func (d Decimal) rescaleExp(exp int, mode rounding) (Decimal, error) {
+	if d.isSpecial() {
+		if d.isSignaling() {
+			return d.quantizeError("Rescale")
+		}
+
+		return d, nil
+	}
+
+	if exp < minUnbiasedExponent || exp > maxUnbiasedExponent {
+		return d.quantizeError("Rescale")
+	}
+
+	res, ok := d.rescale(int16(exp+exponentBias), mode)
+	if !ok {
+		return d.quantizeError("Rescale")
+	}
+
+	return res, nil
+}
+
+func (d Decimal) quantizeError(op string, operands ...Decimal) (Decimal, error) {
+	var res Decimal
+	if d.IsNaN() || len(operands) != 0 && operands[0].IsNaN() {
+		res = propagateNaN(append([]Decimal{d}, operands...)...)
+	} else if len(operands) == 0 {
+		res = nan(payloadOpRescale, d.payloadVal(), 0)
+	} else {
+		res = nan(payloadOpQuantize, d.payloadVal(), operands[0].payloadVal())
+	}
+
+	return res, &ConditionError{
+		Condition: InvalidOperation,
+		Op:        op,
+		Operands:  append([]Decimal{d}, operands...),
+		Result:    res,
+	}
+}
+
+// rescale rounds finite d to the biased exponent exp. It reports false if the
+// coefficient of the result cannot be stored in 34 digits.
+func (d Decimal) rescale(exp int16, mode rounding) (Decimal, bool) {
+	neg := d.Signbit()
+	sig, dExp := d.decompose()
+
+	if sig == (uint128{}) {
+		return compose(neg, sig, exp), true
+	}
+
+	if dExp > exp {
+		if int(dExp-exp) > maxPrecision {
+			return Decimal{}, false
+		}
+
+		for dExp > exp {
+			if sig[1] > 0x0002_7fff_ffff_ffff/10 {
+				return Decimal{}, false
+			}
+
+			sig = sig.mul64(10)
+			dExp--
+		}
+	} else if dExp < exp {
+		var trunc int8
+		var digit, frac uint64
+
+		if int(exp-dExp) > maxDigits {
+			frac = mode.digitsFrac(sig, int(exp-dExp))
+			sig = uint128{}
+			trunc = 1
+		} else {
+			for dExp < exp {
+				if digit != 0 {
+					trunc = 1
+				}
+
+				sig, digit = sig.div10()
+				frac = mode.shiftFrac(frac, trunc, digit, 10)
+				dExp++
+			}
+		}
+
+		sig, _, _ = mode.round(false, neg, sig, exp, trunc, digit, frac)
+	}
+
+	if sig.cmp(uint128PowersOf10[maxPrecision]) >= 0 {
+		return Decimal{}, false
+	}
+
+	return compose(neg, sig, exp), true
+}
+
 // RoundingMode determines how a Decimal value is rounded when the result of an
 // operation is greater than the format can hold.
 type RoundingMode uint8
 
 const (
-	ToNearestEven RoundingMode = iota // == IEEE 754 roundTiesToEven
-	ToNearestAway                     // == IEEE 754 roundTiesToAway
-	ToZero                            // == IEEE 754 roundTowardZero
-	AwayFromZero                      // no IEEE 754 equivalent
-	ToNegativeInf                     // == IEEE 754 roundTowardNegative
-	ToPositiveInf                     // == IEEE 754 roundTowardPositive
+	ToNearestEven       RoundingMode = iota // == IEEE 754 roundTiesToEven
+	ToNearestAway                           // == IEEE 754 roundTiesToAway
+	ToZero                                  // == IEEE 754 roundTowardZero
+	AwayFromZero                            // no IEEE 754 equivalent
+	ToNegativeInf                           // == IEEE 754 roundTowardNegative
+	ToPositiveInf                           // == IEEE 754 roundTowardPositive
+	ToNearestTowardZero                     // no IEEE 754 equivalent, == GDA ROUND_HALF_DOWN
+	ToNearestOdd                            // no IEEE 754 equivalent
+	ToZero05Up                              // no IEEE 754 equivalent, == GDA ROUND_05UP
+	Stochastic                              // no IEEE 754 equivalent, see [NewStochasticRounder]
 )
 
+// rounding is a rounding mode together with the source of the random numbers
+// drawn by Stochastic, which is nil to use the default source.
+type rounding struct {
+	RoundingMode
+	src RandomSource
+}
+
 // String returns a string representation of the rounding mode.
 func (rm RoundingMode) String() string {
 	switch rm {
@@ -278,16 +727,33 @@ func (rm RoundingMode) String() string {
 		return "ToNegativeInf"
 	case ToPositiveInf:
 		return "ToPositiveInf"
+	case ToNearestTowardZero:
+		return "ToNearestTowardZero"
+	case ToNearestOdd:
+		return "ToNearestOdd"
+	case ToZero05Up:
+		return "ToZero05Up"
+	case Stochastic:
+		return "Stochastic"
 	default:
 		return fmt.Sprintf("RoundingMode(%d)", uint8(rm))
 	}
 }
 
-func (rm RoundingMode) reduce256(neg bool, sig256 uint256, exp int16, trunc int8) (uint128, int16) {
+// reduce256 rounds sig256*10**(exp-exponentBias) to fit into a Decimal. A
+// trunc of 1 or -1 means that the value is slightly greater or less than
+// that, by frac units of 2**-64 or by an unknown amount less than one unit
+// if frac is 0. Only stochastic rounding depends on frac.
+func (rm rounding) reduce256(neg bool, sig256 uint256, exp int16, trunc int8, frac uint64) (uint128, int16, bool) {
+	if trunc == -1 {
+		frac = ^frac
+	}
+
 	for sig256[3] > 0 {
 		var rem uint64
 		sig256, rem = sig256.div1e19()
 		exp += 19
+		frac = rm.shiftFrac(frac, trunc, rem, 10_000_000_000_000_000_000)
 
 		if rem != 0 {
 			trunc = 1
@@ -300,6 +766,7 @@ func (rm RoundingMode) reduce256(neg bool, sig256 uint256, exp int16, trunc int8
 		var rem uint64
 		sig192, rem = sig192.div1e8()
 		exp += 8
+		frac = rm.shiftFrac(frac, trunc, rem, 100_000_000)
 
 		if rem != 0 {
 			trunc = 1
@@ -310,6 +777,7 @@ func (rm RoundingMode) reduce256(neg bool, sig256 uint256, exp int16, trunc int8
 		var rem uint64
 		sig192, rem = sig192.div10000()
 		exp += 4
+		frac = rm.shiftFrac(frac, trunc, rem, 10_000)
 
 		if rem != 0 {
 			trunc = 1
@@ -324,6 +792,7 @@ func (rm RoundingMode) reduce256(neg bool, sig256 uint256, exp int16, trunc int8
 		var rem uint64
 		sig, rem = sig.div10000()
 		exp += 4
+		frac = rm.shiftFrac(frac, trunc, rem, 10_000)
 
 		if rem != 0 {
 			digit = rem / 1000
@@ -336,6 +805,7 @@ func (rm RoundingMode) reduce256(neg bool, sig256 uint256, exp int16, trunc int8
 		var rem uint64
 		sig, rem = sig.div1000()
 		exp += 3
+		frac = rm.shiftFrac(frac, trunc, rem, 1000)
 
 		if rem != 0 {
 			digit = rem / 100
@@ -348,6 +818,7 @@ func (rm RoundingMode) reduce256(neg bool, sig256 uint256, exp int16, trunc int8
 		var rem uint64
 		sig, rem = sig.div100()
 		exp += 2
+		frac = rm.shiftFrac(frac, trunc, rem, 100)
 
 		if rem != 0 {
 			digit = rem / 10
@@ -364,6 +835,7 @@ func (rm RoundingMode) reduce256(neg bool, sig256 uint256, exp int16, trunc int8
 		}
 
 		sig, digit = sig.div10()
+		frac = rm.shiftFrac(frac, trunc, digit, 10)
 		exp++
 	}
 
@@ -373,10 +845,12 @@ func (rm RoundingMode) reduce256(neg bool, sig256 uint256, exp int16, trunc int8
 		}
 
 		sig, digit = sig.div10()
+		frac = rm.shiftFrac(frac, trunc, digit, 10)
 
 		if sig == (uint128{}) && digit == 0 {
 			trunc = 0
 			digit = 0
+			frac = 0
 			exp = 0
 			break
 		}
@@ -395,14 +869,19 @@ func (rm RoundingMode) reduce256(neg bool, sig256 uint256, exp int16, trunc int8
 		}
 	}
 
-	return rm.round(true, neg, sig, exp, trunc, digit)
+	return rm.round(true, neg, sig, exp, trunc, digit, frac)
 }
 
-func (rm RoundingMode) reduce192(neg bool, sig192 uint192, exp int16, trunc int8) (uint128, int16) {
+func (rm rounding) reduce192(neg bool, sig192 uint192, exp int16, trunc int8, frac uint64) (uint128, int16, bool) {
+	if trunc == -1 {
+		frac = ^frac
+	}
+
 	if sig192[2] > 10000 {
 		var rem uint64
 		sig192, rem = sig192.div1e8()
 		exp += 8
+		frac = rm.shiftFrac(frac, trunc, rem, 100_000_000)
 
 		if rem != 0 {
 			trunc = 1
@@ -413,6 +892,7 @@ func (rm RoundingMode) reduce192(neg bool, sig192 uint192, exp int16, trunc int8
 		var rem uint64
 		sig192, rem = sig192.div10000()
 		exp += 4
+		frac = rm.shiftFrac(frac, trunc, rem, 10_000)
 
 		if rem != 0 {
 			trunc = 1
@@ -427,6 +907,7 @@ func (rm RoundingMode) reduce192(neg bool, sig192 uint192, exp int16, trunc int8
 		var rem uint64
 		sig, rem = sig.div10000()
 		exp += 4
+		frac = rm.shiftFrac(frac, trunc, rem, 10_000)
 
 		if rem != 0 {
 			digit = rem / 1000
@@ -439,6 +920,7 @@ func (rm RoundingMode) reduce192(neg bool, sig192 uint192, exp int16, trunc int8
 		var rem uint64
 		sig, rem = sig.div1000()
 		exp += 3
+		frac = rm.shiftFrac(frac, trunc, rem, 1000)
 
 		if rem != 0 {
 			digit = rem / 100
@@ -451,6 +933,7 @@ func (rm RoundingMode) reduce192(neg bool, sig192 uint192, exp int16, trunc int8
 		var rem uint64
 		sig, rem = sig.div100()
 		exp += 2
+		frac = rm.shiftFrac(frac, trunc, rem, 100)
 
 		if rem != 0 {
 			digit = rem / 10
@@ -467,6 +950,7 @@ func (rm RoundingMode) reduce192(neg bool, sig192 uint192, exp int16, trunc int8
 		}
 
 		sig, digit = sig.div10()
+		frac = rm.shiftFrac(frac, trunc, digit, 10)
 		exp++
 	}
 
@@ -476,10 +960,12 @@ func (rm RoundingMode) reduce192(neg bool, sig192 uint192, exp int16, trunc int8
 		}
 
 		sig, digit = sig.div10()
+		frac = rm.shiftFrac(frac, trunc, digit, 10)
 
 		if sig == (uint128{}) && digit == 0 {
 			trunc = 0
 			digit = 0
+			frac = 0
 			exp = 0
 			break
 		}
@@ -498,16 +984,21 @@ func (rm RoundingMode) reduce192(neg bool, sig192 uint192, exp int16, trunc int8
 		}
 	}
 
-	return rm.round(true, neg, sig, exp, trunc, digit)
+	return rm.round(true, neg, sig, exp, trunc, digit, frac)
 }
 
-func (rm RoundingMode) reduce128(neg bool, sig uint128, exp int16, trunc int8) (uint128, int16) {
+func (rm rounding) reduce128(neg bool, sig uint128, exp int16, trunc int8, frac uint64) (uint128, int16, bool) {
+	if trunc == -1 {
+		frac = ^frac
+	}
+
 	var digit uint64
 
 	if sig[1] > 0x09c4_0000_0000_0000 {
 		var rem uint64
 		sig, rem = sig.div10000()
 		exp += 4
+		frac = rm.shiftFrac(frac, trunc, rem, 10_000)
 
 		if rem != 0 {
 			digit = rem / 1000
@@ -520,6 +1011,7 @@ func (rm RoundingMode) reduce128(neg bool, sig uint128, exp int16, trunc int8) (
 		var rem uint64
 		sig, rem = sig.div1000()
 		exp += 3
+		frac = rm.shiftFrac(frac, trunc, rem, 1000)
 
 		if rem != 0 {
 			digit = rem / 100
@@ -532,6 +1024,7 @@ func (rm RoundingMode) reduce128(neg bool, sig uint128, exp int16, trunc int8) (
 		var rem uint64
 		sig, rem = sig.div100()
 		exp += 2
+		frac = rm.shiftFrac(frac, trunc, rem, 100)
 
 		if rem != 0 {
 			digit = rem / 10
@@ -548,6 +1041,7 @@ func (rm RoundingMode) reduce128(neg bool, sig uint128, exp int16, trunc int8) (
 		}
 
 		sig, digit = sig.div10()
+		frac = rm.shiftFrac(frac, trunc, digit, 10)
 		exp++
 	}
 
@@ -557,10 +1051,12 @@ func (rm RoundingMode) reduce128(neg bool, sig uint128, exp int16, trunc int8) (
 		}
 
 		sig, digit = sig.div10()
+		frac = rm.shiftFrac(frac, trunc, digit, 10)
 
 		if sig == (uint128{}) && digit == 0 {
 			trunc = 0
 			digit = 0
+			frac = 0
 			exp = 0
 			break
 		}
@@ -579,12 +1075,13 @@ func (rm RoundingMode) reduce128(neg bool, sig uint128, exp int16, trunc int8) (
 		}
 	}
 
-	return rm.round(true, neg, sig, exp, trunc, digit)
+	return rm.round(true, neg, sig, exp, trunc, digit, frac)
 }
 
-func (rm RoundingMode) reduce64(neg bool, sig64 uint64, exp int16) (uint128, int16) {
+func (rm rounding) reduce64(neg bool, sig64 uint64, exp int16) (uint128, int16, bool) {
 	var trunc int8
 	var digit uint64
+	var frac uint64
 
 	for exp < minBiasedExponent {
 		if digit != 0 {
@@ -593,10 +1090,12 @@ func (rm RoundingMode) reduce64(neg bool, sig64 uint64, exp int16) (uint128, int
 
 		digit = sig64 % 10
 		sig64 = sig64 / 10
+		frac = rm.shiftFrac(frac, trunc, digit, 10)
 
 		if sig64 == 0 && digit == 0 {
 			trunc = 0
 			digit = 0
+			frac = 0
 			exp = 0
 			break
 		}
@@ -617,13 +1116,20 @@ func (rm RoundingMode) reduce64(neg bool, sig64 uint64, exp int16) (uint128, int
 		}
 	}
 
-	return rm.round(true, neg, sig, exp, trunc, digit)
+	return rm.round(true, neg, sig, exp, trunc, digit, frac)
 }
 
-func (rm RoundingMode) round(shift, neg bool, sig uint128, exp int16, trunc int8, digit uint64) (uint128, int16) {
+func (rm rounding) round(shift, neg bool, sig uint128, exp int16, trunc int8, digit, frac uint64) (uint128, int16, bool) {
+	inexact := trunc != 0 || digit != 0
+
+	mode := rm.RoundingMode
+	if mode == Stochastic && inexact {
+		mode = rm.stochastic(frac)
+	}
+
 	for {
 		var adjust int
-		switch rm {
+		switch mode {
 		case ToNearestEven:
 			if trunc == 1 {
 				if digit >= 5 {
@@ -643,7 +1149,9 @@ func (rm RoundingMode) round(shift, neg bool, sig uint128, exp int16, trunc int8
 				}
 			}
 		case ToNearestAway:
-			if digit >= 5 {
+			// A digit of 5 with trunc of -1 is just below the halfway point,
+			// such as the result of a subtraction, so it is not a tie.
+			if digit > 5 || digit == 5 && trunc != -1 {
 				adjust = 1
 			}
 		case ToZero:
@@ -670,6 +1178,34 @@ func (rm RoundingMode) round(shift, neg bool, sig uint128, exp int16, trunc int8
 			} else if trunc == -1 && digit == 0 {
 				adjust = -1
 			}
+		case ToNearestTowardZero:
+			if digit > 5 || digit == 5 && trunc == 1 {
+				adjust = 1
+			}
+		case ToNearestOdd:
+			if trunc == 1 {
+				if digit >= 5 {
+					adjust = 1
+				}
+			} else if trunc == -1 {
+				if digit > 5 {
+					adjust = 1
+				}
+			} else {
+				if digit > 5 {
+					adjust = 1
+				} else if digit == 5 {
+					if sig[0]%2 == 0 {
+						adjust = 1
+					}
+				}
+			}
+		case ToZero05Up:
+			if trunc == -1 && digit == 0 {
+				adjust = -1
+			} else if trunc == 1 || digit != 0 {
+				adjust = 1
+			}
 		}
 
 		if adjust != 0 {
@@ -694,6 +1230,10 @@ func (rm RoundingMode) round(shift, neg bool, sig uint128, exp int16, trunc int8
 				}
 
 				tsig = sig.add64(1)
+
+				if mode == ToZero05Up && !sig.last05() {
+					tsig = sig
+				}
 			} else {
 				if shift {
 					if sig != (uint128{}) {
@@ -714,6 +1254,10 @@ func (rm RoundingMode) round(shift, neg bool, sig uint128, exp int16, trunc int8
 				}
 
 				tsig = sig.sub64(1)
+
+				if mode == ToZero05Up && tsig.last05() {
+					tsig = sig
+				}
 			}
 
 			if tsig[1] > 0x0002_7fff_ffff_ffff {
@@ -729,10 +1273,17 @@ func (rm RoundingMode) round(shift, neg bool, sig uint128, exp int16, trunc int8
 			sig = tsig
 		}
 
-		return sig, exp
+		return sig, exp, inexact
 	}
 }
 
-// DefaultRoundingMode is the rounding mode used by any methods where an
+// DefaultRoundingMode is the initial rounding mode used by any methods where an
 // alternate rounding mode isn't provided.
+//
+// DefaultRoundingMode is only used until [SetDefaultRoundingMode] or
+// [FreezeDefaultRoundingMode] is first called.
+//
+// Deprecated: Assigning to DefaultRoundingMode races with every goroutine
+// performing arithmetic. Use [SetDefaultRoundingMode] to set the default once
+// at startup, or a [Rounder] to round individual operations.
 var DefaultRoundingMode RoundingMode = ToNearestEven
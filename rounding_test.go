@@ -9,6 +9,9 @@ var roundingModes = []RoundingMode{
 	AwayFromZero,
 	ToNegativeInf,
 	ToPositiveInf,
+	ToNearestTowardZero,
+	ToNearestOdd,
+	ToZero05Up,
 }
 
 func TestDecimalCeil(t *testing.T) {
@@ -70,6 +73,163 @@ func TestDecimalRound(t *testing.T) {
 	}
 }
 
+func TestDecimalRoundToIncrement(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var inc Decimal
+	var res testDataResult
+
+	for r.scan("roundinc(%v, %v) = %v\n", &val, &inc, &res) {
+		for _, mode := range roundingModes {
+			rnd := val.RoundToIncrement(inc, mode)
+
+			if !res.equal(rnd, mode) {
+				t.Errorf("%v.RoundToIncrement(%v, %v) = %v, want %v", val, inc, mode, rnd, res.result(mode))
+			}
+
+			switch mode {
+			case ToNegativeInf:
+				if flr := val.FloorTo(inc); !resultEqual(flr, rnd) {
+					t.Errorf("%v.FloorTo(%v) = %v, want %v", val, inc, flr, rnd)
+				}
+			case ToPositiveInf:
+				if ceil := val.CeilTo(inc); !resultEqual(ceil, rnd) {
+					t.Errorf("%v.CeilTo(%v) = %v, want %v", val, inc, ceil, rnd)
+				}
+			}
+		}
+	}
+}
+
+func TestDecimalRoundSig(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var n int
+	var res testDataResult
+
+	for r.scan("roundsig(%v, %v) = %v\n", &val, &n, &res) {
+		for _, mode := range roundingModes {
+			rnd := val.RoundSig(n, mode)
+
+			if !res.equal(rnd, mode) {
+				t.Errorf("%v.RoundSig(%d, %v) = %v, want %v", val, n, mode, rnd, res.result(mode))
+			}
+		}
+	}
+}
+
+func TestDecimalTrunc(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var dp int
+	var res Decimal
+
+	for r.scan("trunc(%v, %v) = %v\n", &val, &dp, &res) {
+		rnd := val.Trunc(dp)
+
+		if !resultEqual(rnd, res) {
+			t.Errorf("%v.Trunc(%d) = %v, want %v", val, dp, rnd, res)
+		}
+	}
+}
+
+func TestModf(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var resInt Decimal
+	var resFrac Decimal
+
+	for r.scan("modf(%v) = %v, %v\n", &val, &resInt, &resFrac) {
+		i, frac := Modf(val)
+
+		if !resultEqual(i, resInt) || !resultEqual(frac, resFrac) {
+			t.Errorf("Modf(%v) = (%v, %v), want (%v, %v)", val, i, frac, resInt, resFrac)
+		}
+
+		if res := val.Frac(); !resultEqual(res, resFrac) {
+			t.Errorf("%v.Frac() = %v, want %v", val, res, resFrac)
+		}
+
+		if res := Trunc(val); !resultEqual(res, resInt) {
+			t.Errorf("Trunc(%v) = %v, want %v", val, res, resInt)
+		}
+	}
+}
+
+func TestDecimalQuantize(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var pat Decimal
+	var res testDataResult
+
+	for r.scan("quantize(%v, %v) = %v\n", &val, &pat, &res) {
+		for _, mode := range roundingModes {
+			qnt, err := val.Quantize(pat, mode)
+
+			if !res.equal(qnt, mode) || !qnt.SameQuantum(res.result(mode)) {
+				t.Errorf("%v.Quantize(%v, %v) = %v, want %v", val, pat, mode, qnt, res.result(mode))
+			}
+
+			if qnt.IsNaN() && !val.IsNaN() && !pat.IsNaN() {
+				if err == nil {
+					t.Errorf("%v.Quantize(%v, %v) error = nil, want InvalidOperation", val, pat, mode)
+				}
+			} else if err != nil {
+				t.Errorf("%v.Quantize(%v, %v) error = %v, want nil", val, pat, mode, err)
+			}
+		}
+	}
+}
+
+func TestDecimalRescale(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var exp int
+	var res testDataResult
+
+	for r.scan("rescale(%v, %v) = %v\n", &val, &exp, &res) {
+		for _, mode := range roundingModes {
+			rsc, err := val.Rescale(exp, mode)
+
+			if !res.equal(rsc, mode) || !rsc.SameQuantum(res.result(mode)) {
+				t.Errorf("%v.Rescale(%d, %v) = %v, want %v", val, exp, mode, rsc, res.result(mode))
+			}
+
+			if rsc.IsNaN() && !val.IsNaN() {
+				if err == nil {
+					t.Errorf("%v.Rescale(%d, %v) error = nil, want InvalidOperation", val, exp, mode)
+				}
+			} else if err != nil {
+				t.Errorf("%v.Rescale(%d, %v) error = %v, want nil", val, exp, mode, err)
+			}
+		}
+	}
+}
+
 func BenchmarkReduce128(b *testing.B) {
 	initUintValues()
 
@@ -80,7 +240,7 @@ func BenchmarkReduce128(b *testing.B) {
 		for _, mode := range roundingModes {
 			for _, val := range uint128Values {
 				for _, exp := range exponents {
-					mode.reduce128(false, val, exp, 0)
+					rounding{RoundingMode: mode}.reduce128(false, val, exp, 0, 0)
 				}
 			}
 		}
@@ -97,7 +257,7 @@ func BenchmarkReduce192(b *testing.B) {
 		for _, mode := range roundingModes {
 			for _, val := range uint192Values {
 				for _, exp := range exponents {
-					mode.reduce192(false, val, exp, 0)
+					rounding{RoundingMode: mode}.reduce192(false, val, exp, 0, 0)
 				}
 			}
 		}
@@ -114,7 +274,7 @@ func BenchmarkReduce256(b *testing.B) {
 		for _, mode := range roundingModes {
 			for _, val := range uint256Values {
 				for _, exp := range exponents {
-					mode.reduce256(false, val, exp, 0)
+					rounding{RoundingMode: mode}.reduce256(false, val, exp, 0, 0)
 				}
 			}
 		}
@@ -0,0 +1,125 @@
+package decimal128
+
+// Frexp10 breaks d into a fraction and an integral power of ten, returning a
+// frac such that d == frac × 10**exp, with the absolute value of frac in the
+// interval [0.1, 1). The coefficient of frac is the coefficient of d, so no
+// rounding takes place.
+//
+// Special cases are:
+//
+//	Frexp10(±0) = ±0, 0
+//	Frexp10(±Inf) = ±Inf, 0
+//	Frexp10(NaN) = NaN, 0
+func Frexp10(d Decimal) (frac Decimal, exp int) {
+	if d.isSpecial() {
+		return d.quiet(), 0
+	}
+
+	sig, dExp := d.decompose()
+
+	if sig == (uint128{}) {
+		return d, 0
+	}
+
+	digits := sig.log10() + 1
+	exp = int(dExp) - exponentBias + digits
+
+	return compose(d.Signbit(), sig, int16(exponentBias-digits)), exp
+}
+
+// Ldexp10 is the inverse of [Frexp10]. It returns frac × 10**exp.
+//
+// Ldexp10 is equivalent to:
+//
+//	ScaleB(frac, exp)
+func Ldexp10(frac Decimal, exp int) Decimal {
+	return ScaleB(frac, exp)
+}
+
+// LogB returns the adjusted exponent of d, the exponent of its most
+// significant digit, as an integral Decimal.
+//
+// Special cases are:
+//
+//	LogB(±0) = -Inf
+//	LogB(±Inf) = +Inf
+//	LogB(NaN) = NaN
+func LogB(d Decimal) Decimal {
+	if d.isSpecial() {
+		if d.isInf() {
+			return inf(false)
+		}
+
+		return d.quiet()
+	}
+
+	sig, exp := d.decompose()
+
+	if sig == (uint128{}) {
+		return inf(true)
+	}
+
+	return New(int64(int(exp)-exponentBias+sig.log10()), 0)
+}
+
+// ScaleB returns d × 10**n, computed by adjusting the exponent of d. Results
+// that are too large to be represented overflow to ±Inf, and results too
+// small to be represented exactly are rounded to nearest, ties to even, as in
+// the IEEE 754 scaleB operation.
+func ScaleB(d Decimal, n int) Decimal {
+	if d.isSpecial() {
+		return d.quiet()
+	}
+
+	neg := d.Signbit()
+	sig, dExp := d.decompose()
+
+	n = max(min(n, 2*maxBiasedExponent), -2*maxBiasedExponent)
+	exp := int(dExp) + n
+
+	if sig == (uint128{}) {
+		exp = max(min(exp, maxBiasedExponent), minBiasedExponent)
+		return compose(neg, sig, int16(exp))
+	}
+
+	if exp > maxBiasedExponent {
+		var ok bool
+		if sig, exp, ok = clampExponent(sig, exp); !ok {
+			return inf(neg)
+		}
+	} else if exp < minBiasedExponent {
+		var trunc int8
+		var digit uint64
+
+		if minBiasedExponent-exp > maxDigits {
+			sig = uint128{}
+			exp = minBiasedExponent
+			trunc = 1
+		} else {
+			for exp < minBiasedExponent {
+				if digit != 0 {
+					trunc = 1
+				}
+
+				sig, digit = sig.div10()
+				exp++
+			}
+		}
+
+		sig, _, _ = rounding{RoundingMode: ToNearestEven}.round(false, neg, sig, minBiasedExponent, trunc, digit, 0)
+	}
+
+	return compose(neg, sig, int16(exp))
+}
+
+// clampExponent multiplies sig by ten and decrements exp until exp is no
+// greater than the maximum biased exponent, and reports false if sig would
+// then be too large to encode.
+func clampExponent(sig uint128, exp int) (uint128, int, bool) {
+	for exp > maxBiasedExponent && sig.cmp(minFullSig) < 0 {
+		sig = sig.mul64(10)
+		exp--
+	}
+
+	return sig, exp, exp <= maxBiasedExponent
+}
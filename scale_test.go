@@ -0,0 +1,67 @@
+package decimal128
+
+import "testing"
+
+func TestFrexp10(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var res Decimal
+	var resExp int
+
+	for r.scan("frexp10(%v) = %v, %d\n", &val, &res, &resExp) {
+		frac, exp := Frexp10(val)
+
+		if !resultEqual(frac, res) || !frac.SameQuantum(res) || exp != resExp {
+			t.Errorf("Frexp10(%v) = %v, %d, want %v, %d", val, frac, exp, res, resExp)
+		}
+
+		if val.isSpecial() {
+			continue
+		}
+
+		if ldexp := Ldexp10(frac, exp); !resultEqual(ldexp, val) || !ldexp.SameQuantum(val) {
+			t.Errorf("Ldexp10(%v, %d) = %v, want %v", frac, exp, ldexp, val)
+		}
+	}
+}
+
+func TestLogB(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var res Decimal
+
+	for r.scan("logb(%v) = %v\n", &val, &res) {
+		logb := LogB(val)
+
+		if !resultEqual(logb, res) {
+			t.Errorf("LogB(%v) = %v, want %v", val, logb, res)
+		}
+	}
+}
+
+func TestScaleB(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var n int
+	var res Decimal
+
+	for r.scan("scaleb(%v, %d) = %v\n", &val, &n, &res) {
+		scaleb := ScaleB(val, n)
+
+		if !resultEqual(scaleb, res) || !scaleb.isSpecial() && !scaleb.SameQuantum(res) {
+			t.Errorf("ScaleB(%v, %d) = %v, want %v", val, n, scaleb, res)
+		}
+	}
+}
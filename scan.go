@@ -10,7 +10,7 @@ import (
 // MustParse is like [Parse] but panics if the provided string cannot be parsed,
 // instead of returning an error.
 func MustParse(s string) Decimal {
-	d, err := parse(s, payloadOpMustParse)
+	d, err := parse(s, payloadOpMustParse, DefaultRounder().rounding())
 	if err != nil {
 		panic("decimal128.MustParse(" + strconv.Quote(s) + "): invalid syntax")
 	}
@@ -20,19 +20,20 @@ func MustParse(s string) Decimal {
 
 // Parse parses a Decimal value from the string provided. Parse accepts decimal
 // floating point syntax. An underscore character '_' may appear between digits
-// as a separator. Parse also recognises the string "NaN", and the (possibly
-// signed) strings "Inf" and "Infinity", as their respective special floating
-// point values. It ignores case when matching.
+// as a separator. Parse also recognises the (possibly signed) strings "NaN",
+// "sNaN", "Inf" and "Infinity" as their respective special floating point
+// values. It ignores case when matching. "NaN" and "sNaN" may be followed by up
+// to 33 decimal digits, which are stored as the payload of the NaN.
 //
 // If s is not syntactically well-formed, Parse returns an error that can be
 // compared to [strconv.ErrSyntax] via [errors.Is].
 //
 // If the value is too precise to fit in a Decimal the result is rounded using
-// the [DefaultRoundingMode]. If the value is greater than the largest possible
-// Decimal value, Parse returns ±Inf and an error that can be compared to
-// [strconv.ErrRange] via [errors.Is].
+// the default rounding mode set by [SetDefaultRoundingMode]. If the value is
+// greater than the largest possible Decimal value, Parse returns ±Inf and an
+// error that can be compared to [strconv.ErrRange] via [errors.Is].
 func Parse(s string) (Decimal, error) {
-	return parse(s, payloadOpParse)
+	return parse(s, payloadOpParse, DefaultRounder().rounding())
 }
 
 // Scan implements the [fmt.Scanner] interface. It supports the verbs 'e', 'E',
@@ -102,34 +103,32 @@ func (d *Decimal) Scan(f fmt.ScanState, verb rune) error {
 		return nil
 	}
 
-	if r == 'N' || r == 'n' {
-		r2, _, err := f.ReadRune()
-		if err != nil {
-			if errors.Is(err, io.EOF) {
-				return io.ErrUnexpectedEOF
-			}
-
-			return err
-		}
-
-		if r2 != 'A' && r2 != 'a' {
-			return &parseSyntaxError{s: string([]rune{r, r2})}
-		}
+	if r == 'N' || r == 'n' || r == 'S' || r == 's' {
+		f.UnreadRune()
 
-		r3, _, err := f.ReadRune()
-		if err != nil {
-			if errors.Is(err, io.EOF) {
-				return io.ErrUnexpectedEOF
+		tok, err := f.Token(false, func(r rune) bool {
+			switch {
+			case r >= '0' && r <= '9':
+				return true
+			case r == 'N' || r == 'n' || r == 'A' || r == 'a':
+				return true
+			case r == 'S' || r == 's':
+				return true
+			default:
+				return false
 			}
+		})
 
+		if err != nil {
 			return err
 		}
 
-		if r3 != 'N' && r3 != 'n' {
-			return &parseSyntaxError{s: string([]rune{r, r2, r3})}
+		tmp, ok := parseNaN(tok, neg, payloadOpScan)
+		if !ok {
+			return &parseSyntaxError{string(tok)}
 		}
 
-		*d = nan(payloadOpScan, 0, 0)
+		*d = tmp
 		return nil
 	}
 
@@ -158,7 +157,7 @@ func (d *Decimal) Scan(f fmt.ScanState, verb rune) error {
 		return err
 	}
 
-	tmp, err := parseNumber(tok, neg, true)
+	tmp, err := parseNumber(tok, neg, true, DefaultRounder().rounding())
 	if err != nil {
 		switch err := err.(type) {
 		case parseNumberRangeError:
@@ -176,7 +175,7 @@ func (d *Decimal) Scan(f fmt.ScanState, verb rune) error {
 
 // UnmarshalText implements the [encoding.TextUnmarshaler] interface.
 func (d *Decimal) UnmarshalText(data []byte) error {
-	tmp, err := parse(data, payloadOpUnmarshalText)
+	tmp, err := parse(data, payloadOpUnmarshalText, DefaultRounder().rounding())
 	if err != nil {
 		return err
 	}
@@ -185,7 +184,7 @@ func (d *Decimal) UnmarshalText(data []byte) error {
 	return nil
 }
 
-func parse[D []byte | string](d D, op Payload) (Decimal, error) {
+func parse[D []byte | string](d D, op Payload, mode rounding) (Decimal, error) {
 	if len(d) == 0 {
 		return Decimal{}, &parseSyntaxError{}
 	}
@@ -209,16 +208,17 @@ func parse[D []byte | string](d D, op Payload) (Decimal, error) {
 			return inf(neg), nil
 		}
 
-		if (d[0] == 'N' || d[0] == 'n') && (d[1] == 'A' || d[1] == 'a') && (d[2] == 'N' || d[2] == 'n') {
-			return nan(op, 0, 0), nil
-		}
 	} else if l == 8 {
 		if (d[0] == 'I' || d[0] == 'i') && (d[1] == 'N' || d[1] == 'n') && (d[2] == 'F' || d[2] == 'f') && (d[3] == 'I' || d[3] == 'i') && (d[4] == 'N' || d[4] == 'n') && (d[5] == 'I' || d[5] == 'i') && (d[6] == 'T' || d[6] == 't') && (d[7] == 'Y' || d[7] == 'y') {
 			return inf(neg), nil
 		}
 	}
 
-	v, err := parseNumber(d, neg, true)
+	if v, ok := parseNaN(d, neg, op); ok {
+		return v, nil
+	}
+
+	v, err := parseNumber(d, neg, true, mode)
 	if err != nil {
 		switch err := err.(type) {
 		case parseNumberRangeError:
@@ -233,10 +233,58 @@ func parse[D []byte | string](d D, op Payload) (Decimal, error) {
 	return v, nil
 }
 
-func parseNumber[D []byte | string](d D, neg, sepallowed bool) (Decimal, error) {
+// parseNaN parses the strings "NaN" and "sNaN", ignoring case, optionally
+// followed by the decimal digits of a payload less than 2**109, and gives the
+// result the sign from neg. Larger payloads are rejected, as bit 109 is
+// reserved for payloadDiagnostic. It reports false if d is not a NaN.
+func parseNaN[D []byte | string](d D, neg bool, op Payload) (Decimal, bool) {
+	hi := uint64(0x7c00_0000_0000_0000)
+
+	if neg {
+		hi |= 0x8000_0000_0000_0000
+	}
+
+	if len(d) != 0 && (d[0] == 'S' || d[0] == 's') {
+		hi |= 0x7e00_0000_0000_0000
+		d = d[1:]
+	}
+
+	if len(d) < 3 || !((d[0] == 'N' || d[0] == 'n') && (d[1] == 'A' || d[1] == 'a') && (d[2] == 'N' || d[2] == 'n')) {
+		return Decimal{}, false
+	}
+
+	d = d[3:]
+
+	if len(d) == 0 {
+		return Decimal{uint64(op), hi | payloadDiagnostic}, true
+	}
+
+	if len(d) > maxPrecision-1 {
+		return Decimal{}, false
+	}
+
+	var payload uint128
+	for i := 0; i < len(d); i++ {
+		if d[i] < '0' || d[i] > '9' {
+			return Decimal{}, false
+		}
+
+		payload = payload.mul64(10).add64(uint64(d[i] - '0'))
+	}
+
+	if payload[1] >= payloadDiagnostic {
+		return Decimal{}, false
+	}
+
+	return Decimal{payload[0], hi | payload[1]}, true
+}
+
+func parseNumber[D []byte | string](d D, neg, sepallowed bool, mode rounding) (Decimal, error) {
 	var sig64 uint64
 	var nfrac int16
 	var trunc int8
+	var tail uint64
+	var ntail int
 	caneof := false
 	cansep := false
 	cansgn := false
@@ -338,6 +386,13 @@ func parseNumber[D []byte | string](d D, neg, sepallowed bool) (Decimal, error)
 						trunc = 1
 					}
 
+					// The first digits that do not fit are kept for
+					// stochastic rounding.
+					if ntail < 19 {
+						tail = tail*10 + uint64(c-'0')
+						ntail++
+					}
+
 					if !sawdot {
 						if exp < exponentBias+39 {
 							nfrac--
@@ -429,7 +484,8 @@ func parseNumber[D []byte | string](d D, neg, sepallowed bool) (Decimal, error)
 		return zero(neg), nil
 	}
 
-	sig, exp = DefaultRoundingMode.reduce128(neg, sig, exp+exponentBias, trunc)
+	frac := mode.digitsFrac(uint128{tail, 0}, ntail)
+	sig, exp, _ = mode.reduce128(neg, sig, exp+exponentBias, trunc, frac)
 
 	if exp > maxBiasedExponent {
 		return inf(neg), parseNumberRangeError{}
@@ -112,6 +112,49 @@ func TestParse(t *testing.T) {
 	}
 }
 
+func TestParseNaN(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		val       string
+		signaling bool
+		str       string
+	}{
+		{"NaN", false, "NaN"},
+		{"sNaN", true, "sNaN"},
+		{"nan123", false, "NaN123"},
+		{"SNAN42", true, "sNaN42"},
+		{"-NaN7", false, "-NaN7"},
+		{"-sNaN2", true, "-sNaN2"},
+		{"+NaN3", false, "NaN3"},
+		{"NaN0", false, "NaN"},
+		{"NaN649037107316853453566312041152511", false, "NaN649037107316853453566312041152511"},
+	}
+
+	for _, test := range tests {
+		res, err := Parse(test.val)
+		if err != nil || !res.IsNaN() || res.IsSignalingNaN() != test.signaling || res.IsQuietNaN() == test.signaling {
+			t.Errorf("Parse(%s) = (%v, %v), want signaling %t", test.val, res, err, test.signaling)
+		}
+
+		if str := res.String(); str != test.str {
+			t.Errorf("Parse(%s).String() = %s, want %s", test.val, str, test.str)
+		}
+
+		var scanned Decimal
+		_, err = fmt.Sscan(test.val, &scanned)
+		if err != nil || scanned.String() != test.str {
+			t.Errorf("fmt.Sscan(%q) = (%v, %v), want %s", test.val, scanned, err, test.str)
+		}
+	}
+
+	for _, val := range []string{"NaN9999999999999999999999999999999999", "NaN649037107316853453566312041152512", "NaN999999999999999999999999999999999", "NaNx", "sNa", "sNaN1.5", "NaN-1"} {
+		if res, err := Parse(val); !errors.Is(err, strconv.ErrSyntax) {
+			t.Errorf("Parse(%s) = (%v, %v), want invalid syntax", val, res, err)
+		}
+	}
+}
+
 func FuzzParse(f *testing.F) {
 	f.Add("123_456.789e10")
 	f.Add("+Inf")
@@ -0,0 +1,134 @@
+package decimal128
+
+import "math/bits"
+
+// A RandomSource is a source of uniformly distributed random uint64 values,
+// such as the sources and the Rand type of math/rand/v2.
+type RandomSource interface {
+	Uint64() uint64
+}
+
+// NewStochasticRounder returns a Rounder that rounds using [Stochastic],
+// drawing its random numbers from src. If src is nil the random numbers are
+// drawn from the top-level functions of math/rand/v2, which is also the source
+// used when Stochastic is passed directly to a function or method.
+//
+// Stochastic rounding rounds an inexact result away from zero with a
+// probability equal to the discarded fraction of a unit in the last place, and
+// towards zero otherwise. Unlike the other rounding modes it is unbiased on
+// average, which makes it useful for simulations and for accumulating many
+// small values that would otherwise be rounded away. The probability is
+// computed from all of the discarded digits, to within 2**-64, and a new
+// random number is drawn every time a result is rounded. Exact results are
+// never changed.
+//
+// The Rounder is safe for concurrent use by multiple goroutines if src is. The
+// same source can be used by a [Context] by setting its Source.
+func NewStochasticRounder(src RandomSource) Rounder {
+	return Rounder{Mode: Stochastic, Source: src}
+}
+
+// stochastic returns AwayFromZero with a probability of frac, the discarded
+// fraction in units of 2**-64, and ToZero otherwise.
+func (rm rounding) stochastic(frac uint64) RoundingMode {
+	var u uint64
+	if rm.src == nil {
+		u = randUint64()
+	} else {
+		u = rm.src.Uint64()
+	}
+
+	if u < frac {
+		return AwayFromZero
+	}
+
+	return ToZero
+}
+
+// shiftFrac returns the fraction that is discarded when the digits rem, which
+// are less than pow, are discarded in front of the fraction frac. Fractions
+// are in units of 2**-64, and are only computed for stochastic rounding
+// modes. A trunc of -1 means that the value is slightly less than the digits,
+// so frac is measured from one less than them.
+func (rm rounding) shiftFrac(frac uint64, trunc int8, rem, pow uint64) uint64 {
+	if rm.RoundingMode != Stochastic {
+		return 0
+	}
+
+	if trunc == -1 {
+		if rem == 0 {
+			rem = pow
+		}
+
+		rem--
+	}
+
+	q, _ := bits.Div64(rem, frac, pow)
+	return q
+}
+
+// quoFrac returns n/d, which is less than one, in units of 2**-64. It is only
+// computed for stochastic rounding modes.
+func (rm rounding) quoFrac(n, d uint128) uint64 {
+	if rm.RoundingMode != Stochastic || n == (uint128{}) {
+		return 0
+	}
+
+	q, _ := uint192{0, n[0], n[1]}.div(uint192{d[0], d[1], 0})
+	return q[0]
+}
+
+// digitsFrac returns the fractional part of n/10**k in units of 2**-64, which
+// is the fraction discarded when the last k digits of n are dropped. It is
+// only computed for stochastic rounding modes.
+func (rm rounding) digitsFrac(n uint128, k int) uint64 {
+	if rm.RoundingMode != Stochastic {
+		return 0
+	}
+
+	f := uint192{0, n[0], n[1]}
+
+	for ; k >= 19 && f != (uint192{}); k -= 19 {
+		f, _ = f.div1e19()
+	}
+
+	for ; k >= 4 && f != (uint192{}); k -= 4 {
+		f, _ = f.div10000()
+	}
+
+	for ; k > 0 && f != (uint192{}); k-- {
+		f, _ = f.div10()
+	}
+
+	return f[0]
+}
+
+// scaledFrac returns n/(d*10**k), which is less than one, in units of
+// 2**-64. It is only computed for stochastic rounding modes.
+func (rm rounding) scaledFrac(n, d uint192, k int16) uint64 {
+	if rm.RoundingMode != Stochastic {
+		return 0
+	}
+
+	for ; k > 0 && d[2] < 1<<59; k-- {
+		d = d.mul64(10)
+	}
+
+	if k > 0 {
+		return 0
+	}
+
+	var q uint64
+
+	for i := 0; i < 64; i++ {
+		n = n.lsh(1)
+		q <<= 1
+
+		if n.cmp(d) >= 0 {
+			n, _ = n.sub(d)
+			q |= 1
+		}
+	}
+
+	return q
+}
@@ -0,0 +1,148 @@
+package decimal128
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// seqSource returns its values in order, and then zeros.
+type seqSource []uint64
+
+func (s *seqSource) Uint64() uint64 {
+	if len(*s) == 0 {
+		return 0
+	}
+
+	u := (*s)[0]
+	*s = (*s)[1:]
+
+	return u
+}
+
+func TestStochasticRounder(t *testing.T) {
+	t.Parallel()
+
+	// The same rounder is used for every operation, which must still round
+	// each of them independently.
+	sr := NewStochasticRounder(rand.New(rand.NewSource(1)))
+
+	tests := []struct {
+		name string
+		op   func(r Rounder) Decimal
+		prob float64
+	}{
+		{"1.3.Round(0)", func(r Rounder) Decimal { return r.Round(MustParse("1.3"), 0) }, 0.3},
+		{"-2.75.Round(1)", func(r Rounder) Decimal { return r.Round(MustParse("-2.75"), 1) }, 0.5},
+		{"2.0999.Round(0)", func(r Rounder) Decimal { return r.Round(MustParse("2.0999"), 0) }, 0.0999},
+		{"-7.999.Round(0)", func(r Rounder) Decimal { return r.Round(MustParse("-7.999"), 0) }, 0.999},
+		{"4.Round(0)", func(r Rounder) Decimal { return r.Round(New(4, 0), 0) }, 0},
+		{"123456789.RoundSig(3)", func(r Rounder) Decimal { return r.RoundSig(New(123456789, 0), 3) }, 0.456789},
+		{"1.26.RoundToIncrement(0.25)", func(r Rounder) Decimal {
+			return r.RoundToIncrement(MustParse("1.26"), MustParse("0.25"))
+		}, 0.04},
+		{"1+3e-36", func(r Rounder) Decimal { return r.Add(New(1, 0), New(3, -36)) }, 0.03},
+		{"1-3e-36", func(r Rounder) Decimal { return r.Sub(New(1, 0), New(3, -36)) }, 0.97},
+		{"1.3*1.0000000000000000000000000000000001", func(r Rounder) Decimal {
+			return r.Mul(MustParse("1.3"), MustParse("1.0000000000000000000000000000000001"))
+		}, 0.13},
+		{"1/3", func(r Rounder) Decimal { return r.Quo(New(1, 0), New(3, 0)) }, 1.0 / 3},
+		{"-2/3", func(r Rounder) Decimal { return r.Quo(New(-2, 0), New(3, 0)) }, 2.0 / 3},
+		{"sqrt(2)", func(r Rounder) Decimal { return r.Sqrt(New(2, 0)) }, 0.0785696},
+		{"Parse", func(r Rounder) Decimal {
+			res, _ := r.Parse("0.123456789012345678901234567890123450999999")
+			return res
+		}, 0.0999999},
+		{"Context{Precision: 3}.Quo(2, 3)", func(r Rounder) Decimal {
+			c := Context{Precision: 3, Mode: r.Mode, Source: r.Source}
+			return c.Quo(New(2, 0), New(3, 0))
+		}, 2.0 / 3},
+		{"QuoWithMode(1, 3)", func(r Rounder) Decimal {
+			// Stochastic passed directly uses the default source.
+			return New(1, 0).QuoWithMode(New(3, 0), r.Mode)
+		}, 1.0 / 3},
+	}
+
+	const n = 20000
+
+	for _, tt := range tests {
+		down := tt.op(Rounder{Mode: ToZero})
+		up := tt.op(Rounder{Mode: AwayFromZero})
+
+		var ups int
+		for i := 0; i < n; i++ {
+			res := tt.op(sr)
+
+			switch {
+			case res.Equal(down):
+			case res.Equal(up):
+				ups++
+			default:
+				t.Fatalf("%s with Stochastic = %v, want %v or %v", tt.name, res, down, up)
+			}
+		}
+
+		if prob := float64(ups) / n; prob < tt.prob-0.015 || prob > tt.prob+0.015 {
+			t.Errorf("%s with Stochastic rounded away from zero with probability %v, want %v", tt.name, prob, tt.prob)
+		}
+	}
+
+	// Adding a value that is a fraction of a unit in the last place many
+	// times accumulates it on average.
+	sum := New(1, 0)
+	for i := 0; i < n; i++ {
+		sum = sr.Add(sum, New(3, -36))
+	}
+
+	if want := MustParse("1.0000000000000000000000000000000600"); !sum.EqualWithin(want, New(100, -34), Decimal{}) {
+		t.Errorf("sum of 1 and %d * 3e-36 with Stochastic = %v, want %v", n, sum, want)
+	}
+
+	if res := sr.Mode.String(); res != "Stochastic" {
+		t.Errorf("Stochastic.String() = %v, want Stochastic", res)
+	}
+}
+
+func TestStochasticRounderSource(t *testing.T) {
+	t.Parallel()
+
+	// A rounder only draws from its own source, so rounders with equally
+	// seeded sources round identically however many of them are created.
+	for i := 0; i < 1000; i++ {
+		r1 := NewStochasticRounder(rand.New(rand.NewSource(int64(i))))
+		r2 := NewStochasticRounder(rand.New(rand.NewSource(int64(i))))
+
+		x := r1.Quo(New(int64(i), 0), New(7, 0))
+		if y := r2.Quo(New(int64(i), 0), New(7, 0)); x != y {
+			t.Fatalf("%d/7 with equally seeded StochasticRounders = %v and %v, want equal", i, x, y)
+		}
+	}
+}
+
+func TestStochasticRounderThreshold(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		val  string
+		draw uint64
+		want string
+	}{
+		{"0.25", 1<<62 - 1, "1"},
+		{"0.25", 1 << 62, "0"},
+		{"0.2500000000000000001", 1 << 62, "1"},
+		{"0.2500000000000000001", 1<<62 + 1, "0"},
+		{"0.2499999999999999999", 1<<62 - 3, "1"},
+		{"0.2499999999999999999", 1<<62 - 2, "0"},
+		{"-0.75", 3<<62 - 1, "-1"},
+		{"-0.75", 3 << 62, "-0"},
+		{"5", 0, "5"},
+	}
+
+	for _, tt := range tests {
+		src := seqSource{tt.draw}
+		r := NewStochasticRounder(&src)
+
+		if res := r.Round(MustParse(tt.val), 0); res.String() != tt.want {
+			t.Errorf("%v.Round(0, Stochastic) with a draw of %#x = %v, want %v", tt.val, tt.draw, res, tt.want)
+		}
+	}
+}
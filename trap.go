@@ -0,0 +1,151 @@
+package decimal128
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ConditionError is the error returned by a [Trapper] when an operation
+// signals one of its trapped conditions.
+type ConditionError struct {
+	// Condition holds the trapped conditions signalled by the operation.
+	Condition Condition
+
+	// Op is the name of the operation, such as "Quo".
+	Op string
+
+	// Operands holds the operands the operation was performed on.
+	Operands []Decimal
+
+	// Result is the value the operation would have returned had none of the
+	// conditions been trapped.
+	Result Decimal
+}
+
+// Error returns a string representation of the error.
+func (err *ConditionError) Error() string {
+	var buf strings.Builder
+	buf.WriteString(err.Op)
+	buf.WriteByte('(')
+
+	for i, v := range err.Operands {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+
+		buf.WriteString(v.String())
+	}
+
+	buf.WriteString("): ")
+	buf.WriteString(err.Condition.String())
+
+	return buf.String()
+}
+
+// Is reports whether the error signalled Overflow or Underflow when target is
+// [strconv.ErrRange].
+func (err *ConditionError) Is(target error) bool {
+	return target == strconv.ErrRange && err.Condition&(Overflow|Underflow) != 0
+}
+
+// Trapper performs arithmetic in the same way as its [Context], but returns a
+// *[ConditionError] from any operation that signals one of the conditions in
+// Traps. Conditions that are not trapped are only recorded in Flags.
+//
+// The result of the operation is returned alongside the error, so callers can
+// still inspect the Inf or NaN value a trapped operation produced.
+type Trapper struct {
+	Context
+
+	// Traps is the set of conditions that cause an operation to fail.
+	Traps Condition
+}
+
+// Checked returns a Trapper using the default Context which traps the
+// provided conditions.
+func Checked(traps Condition) *Trapper {
+	return &Trapper{Traps: traps}
+}
+
+// Add adds x and y and returns the result.
+func (t *Trapper) Add(x, y Decimal) (Decimal, error) {
+	flags := t.swapFlags()
+	return t.check(flags, "Add", t.Context.Add(x, y), x, y)
+}
+
+// Exp returns e**d, the base-e exponential of d.
+func (t *Trapper) Exp(d Decimal) (Decimal, error) {
+	flags := t.swapFlags()
+	return t.check(flags, "Exp", t.Context.Exp(d), d)
+}
+
+// Log returns the natural logarithm of d.
+func (t *Trapper) Log(d Decimal) (Decimal, error) {
+	flags := t.swapFlags()
+	return t.check(flags, "Log", t.Context.Log(d), d)
+}
+
+// Mul multiplies x and y and returns the result.
+func (t *Trapper) Mul(x, y Decimal) (Decimal, error) {
+	flags := t.swapFlags()
+	return t.check(flags, "Mul", t.Context.Mul(x, y), x, y)
+}
+
+// Pow raises x to the power of y and returns the result.
+func (t *Trapper) Pow(x, y Decimal) (Decimal, error) {
+	flags := t.swapFlags()
+	return t.check(flags, "Pow", t.Context.Pow(x, y), x, y)
+}
+
+// Quo divides x by y and returns the result.
+func (t *Trapper) Quo(x, y Decimal) (Decimal, error) {
+	flags := t.swapFlags()
+	return t.check(flags, "Quo", t.Context.Quo(x, y), x, y)
+}
+
+// QuoRem divides x by y and returns the result as an integer quotient and a
+// remainder.
+func (t *Trapper) QuoRem(x, y Decimal) (Decimal, Decimal, error) {
+	flags := t.swapFlags()
+	quo, rem := t.Context.QuoRem(x, y)
+	_, err := t.check(flags, "QuoRem", quo, x, y)
+	return quo, rem, err
+}
+
+// Sqrt returns the square root of d.
+func (t *Trapper) Sqrt(d Decimal) (Decimal, error) {
+	flags := t.swapFlags()
+	return t.check(flags, "Sqrt", t.Context.Sqrt(d), d)
+}
+
+// Sub subtracts y from x and returns the result.
+func (t *Trapper) Sub(x, y Decimal) (Decimal, error) {
+	flags := t.swapFlags()
+	return t.check(flags, "Sub", t.Context.Sub(x, y), x, y)
+}
+
+// swapFlags clears Flags, so the conditions signalled by the next operation
+// can be told apart, and returns their previous value.
+func (t *Trapper) swapFlags() Condition {
+	flags := t.Flags
+	t.Flags = 0
+	return flags
+}
+
+// check restores the previous flags and returns a *ConditionError if the
+// operation signalled a trapped condition.
+func (t *Trapper) check(flags Condition, op string, res Decimal, operands ...Decimal) (Decimal, error) {
+	signalled := t.Flags
+	t.Flags |= flags
+
+	if signalled&t.Traps == 0 {
+		return res, nil
+	}
+
+	return res, &ConditionError{
+		Condition: signalled & t.Traps,
+		Op:        op,
+		Operands:  operands,
+		Result:    res,
+	}
+}
@@ -0,0 +1,61 @@
+package decimal128
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestTrapper(t *testing.T) {
+	t.Parallel()
+
+	trp := Checked(DivisionByZero | InvalidOperation | Overflow)
+
+	res, err := trp.Quo(MustParse("1"), MustParse("3"))
+	if err != nil {
+		t.Errorf("Quo(1, 3) = %v, %v, want nil error", res, err)
+	}
+
+	res, err = trp.Quo(MustParse("1"), MustParse("0"))
+
+	var cerr *ConditionError
+	if !errors.As(err, &cerr) {
+		t.Fatalf("Quo(1, 0) = %v, %v, want *ConditionError", res, err)
+	}
+
+	if cerr.Condition != DivisionByZero || cerr.Op != "Quo" || len(cerr.Operands) != 2 || !res.IsInf(1) {
+		t.Errorf("Quo(1, 0) = %v, %#v, want Inf, DivisionByZero", res, cerr)
+	}
+
+	if msg := err.Error(); msg != "Quo(1, 0): DivisionByZero" {
+		t.Errorf("err.Error() = %q, want %q", msg, "Quo(1, 0): DivisionByZero")
+	}
+
+	if trp.Flags != Inexact|Rounded|DivisionByZero {
+		t.Errorf("Flags = %v, want %v", trp.Flags, Inexact|Rounded|DivisionByZero)
+	}
+
+	_, err = trp.Sqrt(MustParse("-1"))
+	if !errors.As(err, &cerr) || cerr.Condition != InvalidOperation {
+		t.Errorf("Sqrt(-1) error = %v, want InvalidOperation", err)
+	}
+
+	_, err = trp.Mul(MustParse("9E+6144"), MustParse("10"))
+	if !errors.As(err, &cerr) || cerr.Condition != Overflow {
+		t.Errorf("Mul(9E+6144, 10) error = %v, want Overflow", err)
+	}
+
+	if !errors.Is(err, strconv.ErrRange) {
+		t.Errorf("errors.Is(%v, strconv.ErrRange) = false, want true", err)
+	}
+
+	_, err = trp.Add(MustParse("NaN"), MustParse("1"))
+	if err != nil {
+		t.Errorf("Add(NaN, 1) error = %v, want nil", err)
+	}
+
+	_, _, err = trp.QuoRem(MustParse("1"), MustParse("0"))
+	if !errors.As(err, &cerr) || cerr.Condition != DivisionByZero|InvalidOperation {
+		t.Errorf("QuoRem(1, 0) error = %v, want DivisionByZero|InvalidOperation", err)
+	}
+}
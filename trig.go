@@ -0,0 +1,409 @@
+package decimal128
+
+import "math/big"
+
+// Cos returns the cosine of d, where d is in radians.
+func Cos(d Decimal) Decimal {
+	res, _ := d.cos(DefaultRounder().rounding())
+	return res
+}
+
+// CosWithMode returns the cosine of d, where d is in radians, rounded using
+// the provided rounding mode.
+func CosWithMode(d Decimal, mode RoundingMode) Decimal {
+	res, _ := d.cos(rounding{RoundingMode: mode})
+	return res
+}
+
+func (d Decimal) cos(mode rounding) (Decimal, bool) {
+	if d.isSpecial() {
+		if d.IsNaN() {
+			return d.quiet(), false
+		}
+
+		return nan(payloadOpCos, d.payloadVal(), 0), false
+	}
+
+	if d.IsZero() {
+		return one(false), false
+	}
+
+	return d.trigArg().eval(trigCos, mode), true
+}
+
+// Sin returns the sine of d, where d is in radians.
+func Sin(d Decimal) Decimal {
+	res, _ := d.sin(DefaultRounder().rounding())
+	return res
+}
+
+// SinWithMode returns the sine of d, where d is in radians, rounded using the
+// provided rounding mode.
+func SinWithMode(d Decimal, mode RoundingMode) Decimal {
+	res, _ := d.sin(rounding{RoundingMode: mode})
+	return res
+}
+
+func (d Decimal) sin(mode rounding) (Decimal, bool) {
+	if d.isSpecial() {
+		if d.IsNaN() {
+			return d.quiet(), false
+		}
+
+		return nan(payloadOpSin, d.payloadVal(), 0), false
+	}
+
+	if d.IsZero() {
+		return d, false
+	}
+
+	return d.trigArg().eval(trigSin, mode), true
+}
+
+// Sincos returns Sin(d), Cos(d). The argument is only reduced once, so it is
+// faster than calling both.
+func Sincos(d Decimal) (sin, cos Decimal) {
+	return d.sincos(DefaultRounder().rounding())
+}
+
+// SincosWithMode returns SinWithMode(d, mode), CosWithMode(d, mode).
+func SincosWithMode(d Decimal, mode RoundingMode) (sin, cos Decimal) {
+	return d.sincos(rounding{RoundingMode: mode})
+}
+
+func (d Decimal) sincos(mode rounding) (Decimal, Decimal) {
+	if d.isSpecial() || d.IsZero() {
+		sin, _ := d.sin(mode)
+		cos, _ := d.cos(mode)
+
+		return sin, cos
+	}
+
+	arg := d.trigArg()
+
+	return arg.eval(trigSin, mode), arg.eval(trigCos, mode)
+}
+
+// Tan returns the tangent of d, where d is in radians.
+func Tan(d Decimal) Decimal {
+	res, _ := d.tan(DefaultRounder().rounding())
+	return res
+}
+
+// TanWithMode returns the tangent of d, where d is in radians, rounded using
+// the provided rounding mode.
+func TanWithMode(d Decimal, mode RoundingMode) Decimal {
+	res, _ := d.tan(rounding{RoundingMode: mode})
+	return res
+}
+
+func (d Decimal) tan(mode rounding) (Decimal, bool) {
+	if d.isSpecial() {
+		if d.IsNaN() {
+			return d.quiet(), false
+		}
+
+		return nan(payloadOpTan, d.payloadVal(), 0), false
+	}
+
+	if d.IsZero() {
+		return d, false
+	}
+
+	return d.trigArg().eval(trigTan, mode), true
+}
+
+// trigFunc selects the trigonometric function computed by trigArg.eval and
+// Decimal.trigBig.
+type trigFunc uint8
+
+const (
+	trigSin trigFunc = iota
+	trigCos
+	trigTan
+)
+
+// trigArg is the argument d of a trigonometric function, reduced such that
+// |d| = (4*k + q) * π/2 + r for an integer k. The reduced argument r is
+// negative if neg is true, and |r| is no greater than one.
+type trigArg struct {
+	d    Decimal
+	q    int
+	neg  bool
+	r    decomposed192
+	tiny bool
+}
+
+// trigArg reduces d, which is finite and not zero.
+func (d Decimal) trigArg() trigArg {
+	dSig, dExp := d.decompose()
+	exp := dExp - exponentBias
+	adj := int16(dSig.log10()) + exp
+
+	// The functions differ from their first term by a relative amount of
+	// less than d**2, too little to affect anything but the direction in
+	// which they are rounded.
+	if adj < -20 {
+		return trigArg{d: d, tiny: true}
+	}
+
+	if adj < 0 {
+		return trigArg{
+			d: d,
+			r: decomposed192{
+				sig: uint192{dSig[0], dSig[1], 0},
+				exp: exp,
+			},
+		}
+	}
+
+	q, f, n := trigReduce(bigUint128(dSig), int(exp), 57)
+	neg := f.Sign() < 0
+	f.Abs(f)
+
+	var trunc int8
+	if shift := bigDigits(f) - 56; shift > 0 {
+		var rem big.Int
+		f.QuoRem(f, bigPow10(shift), &rem)
+		n -= shift
+
+		if rem.Sign() != 0 {
+			trunc = 1
+		}
+	}
+
+	var buf [24]byte
+	f.FillBytes(buf[:])
+
+	var sig uint192
+	for i := range sig {
+		for _, b := range buf[24-8*(i+1) : 24-8*i] {
+			sig[i] = sig[i]<<8 | uint64(b)
+		}
+	}
+
+	r, _ := decomposed192{
+		sig: sig,
+		exp: int16(-n),
+	}.mul(halfPi, trunc)
+
+	return trigArg{d: d, q: q, neg: neg, r: r}
+}
+
+// eval returns the sine, cosine or tangent of the argument, rounded using the
+// provided rounding mode.
+func (a trigArg) eval(fn trigFunc, mode rounding) Decimal {
+	// Sine and tangent are odd functions, and cosine is even.
+	neg := a.d.Signbit() && fn != trigCos
+
+	if a.tiny {
+		dSig, dExp := a.d.decompose()
+
+		switch fn {
+		case trigSin:
+			// sin(d) is slightly closer to zero than d.
+			sig, exp, _ := mode.reduce128(neg, dSig, dExp, -1, 0)
+			return compose(neg, sig, exp)
+		case trigCos:
+			// cos(d) is slightly less than one.
+			sig, exp := mode.reduceNearOne(false)
+			return compose(false, sig, exp)
+		default:
+			// tan(d) is slightly further from zero than d.
+			sig, exp, _ := mode.reduce128(neg, dSig, dExp, 1, 0)
+			return compose(neg, sig, exp)
+		}
+	}
+
+	// cos(x) = sin(x + π/2), and the sine is, in turn for each quadrant,
+	// sin(r), cos(r), -sin(r) and -cos(r).
+	q := a.q
+	if fn == trigCos {
+		q++
+	}
+
+	var res decomposed192
+	resNeg := a.neg
+
+	switch {
+	case fn == trigTan && q%2 == 0:
+		sin, trunc := a.r.sin(0)
+		cos, _ := a.r.cos(0)
+		res, _ = sin.quo(cos, trunc)
+	case fn == trigTan:
+		// tan(x) = -cot(r) in the second and fourth quadrants.
+		sin, trunc := a.r.sin(0)
+		cos, _ := a.r.cos(0)
+		res, _ = cos.quo(sin, trunc)
+		resNeg = !resNeg
+	case q%2 == 0:
+		res, _ = a.r.sin(0)
+	default:
+		res, _ = a.r.cos(0)
+		resNeg = false
+	}
+
+	if fn != trigTan && q%4 >= 2 {
+		resNeg = !resNeg
+	}
+
+	neg = neg != resNeg
+
+	sig, exp, ok := mode.reduceApprox(neg, res, 50)
+	if !ok {
+		sig, exp, neg = a.d.trigBig(fn, mode)
+	}
+
+	return compose(neg, sig, exp)
+}
+
+// trigReduce returns q, f and n such that c*10**e * 2/π = 4*k + q + f*10**-n
+// for an integer k, where c*10**e is at least one, |f*10**-n| is no greater
+// than 1/2, and f has at least prec correct digits, or as many as the digits
+// of 2/π in twoOverPi allow.
+//
+// This is a Payne–Hanek reduction: the digits of 2/π that only contribute
+// multiples of four to the product are skipped, as are those too small to
+// affect f, so the cost does not grow with e.
+func trigReduce(c *big.Int, e, prec int) (int, *big.Int, int) {
+	// The error of f is less than c, which has no more than maxDigits
+	// digits. More digits are used when f has leading zeros.
+	for n := prec + maxDigits + 2; ; {
+		capped := e+n >= twoOverPiDigits
+		if capped {
+			n = twoOverPiDigits - e
+		}
+
+		unit := bigPow10(n)
+		y := new(big.Int).Mul(c, bigTwoOverPi(max(e-2, 0), e+n))
+
+		k, f := new(big.Int).QuoRem(y, unit, new(big.Int))
+		q := int(k.And(k, big.NewInt(3)).Int64())
+
+		if new(big.Int).Lsh(f, 1).Cmp(unit) > 0 {
+			f.Sub(f, unit)
+			q = (q + 1) % 4
+		}
+
+		missing := prec + maxDigits + 1 - bigDigits(f)
+		if missing <= 0 || capped {
+			return q, f, n
+		}
+
+		n += missing
+	}
+}
+
+// bigTwoOverPi returns the integer formed by the digits of 2/π after the
+// decimal point from position lo+1 to position hi, that is floor(2/π *
+// 10**hi) mod 10**(hi-lo).
+func bigTwoOverPi(lo, hi int) *big.Int {
+	first, last := lo/19, (hi+18)/19
+	limb := new(big.Int).SetUint64(10_000_000_000_000_000_000)
+
+	res := new(big.Int)
+	for _, digits := range twoOverPi[first:last] {
+		res.Mul(res, limb)
+		res.Add(res, new(big.Int).SetUint64(digits))
+	}
+
+	res = bigShift(res, hi-19*last)
+
+	return res.Mod(res, bigPow10(hi-lo))
+}
+
+// twoOverPiDigits is the number of digits of 2/π held by twoOverPi. They allow
+// the reduction of the largest Decimal with several hundred digits to spare.
+const twoOverPiDigits = len(twoOverPi) * 19
+
+// twoOverPi holds the digits of 2/π after the decimal point, 19 at a time.
+var twoOverPi = [...]uint64{
+	6366197723675813430, 7553505349005744813, 7838582961825794990, 6693762355871905369,
+	614036045521106501, 2343824291370907031, 8321475716473844583, 1461151186964292679,
+	9356916959867749636, 3102923109855877012, 3075486957158486959, 646773449560966894,
+	5160473295204568907, 9902286376184756034, 7610695824481957643, 7477513763421148923,
+	9978577360099468939, 957838443593292387, 1322996246679458512, 1879779460875152629,
+	9146267856964155983, 4965573944399354723, 9679984977150234068, 4715433724470075068,
+	6421861901479520389, 5784145903733507223, 7209977986541221308, 6271020128812991112,
+	6558866409178699247, 8392663362424067212, 1439925356479499953, 3114661774111902028,
+	64962710257555398, 2852435204887975045, 9072551105895156253, 2272185831913927045,
+	2497092562798431000, 9800119103942835622, 7611187140526100840, 652709840836992464,
+	2496224582481258593, 6356993836765740846, 3016302248034861064, 2720886863656302989,
+	8330890390985141599, 5006213175632559270, 8963743301918829331, 4876162799903630630,
+	8313973881574359312, 3486937025614675804, 6650182823773310525, 746001044908718846,
+	1284503980175467178, 150502243345268467, 8103903251289976649, 3337258042449414751,
+	4252454546768668568, 2789878405170023133, 4421247843437803935, 8226874839818986041,
+	7264952620703233577, 7191988399802101755, 264517783533227384, 2031411660605641619,
+	5719540255526431047, 8797229364155998314, 7675623923749510882, 4750172890875720546,
+	5021044955121550155, 5244272562706173633, 1311410773370719822, 4283161544241410955,
+	9849805039829971051, 8809437638233720465, 9318564742310849623, 177978280871590791,
+	6963796130917908086, 6598414261272614176, 153627594988707663, 5505276386602785761,
+	9107882750734627112, 4191191818014135830, 3320752735475175106, 4499259812239862320,
+	8763343950041405085, 1617292632199487874, 7511037862653848841, 3681776342199140151,
+	7095477717414647751, 1317149437513738812, 9209485833516942284, 7454536771784073272,
+	9167856660035132317, 3254139911639898345, 9716106980243957475, 6378353220134812215,
+	2218924928632377279, 704129132525675923, 8999289753340697427, 9593900041580027355,
+	2015914689439843209, 6010956043499819419, 1516942730445597956, 1307598970833398445,
+	9683315615107138972, 1420182738243346859, 1723382689330814194, 1570224808347357296,
+	3982488470132735760, 8388317428309986199, 5234744265443874647, 8681498981684113248,
+	7700738489933996464, 4598266224151878704, 5597251319843104331, 1196040313214400935,
+	3091951634160955046, 2297817237040476402, 1735199355618619684, 9931806428291412020,
+	9088409440700932526, 9271903724420131262, 437495654558581223, 1704287203344718195,
+	689858392189590916, 9792436803748503147, 6733315835451359617, 4347466655902693780,
+	5638014549308766972, 4555226553229036921, 1038938024219285111, 2148261351132128683,
+	9509398662739632013, 795402696716585873, 4033126467413257344, 6429239805994124792,
+	7893503377683936662, 3816609002573577251, 4577615355342460351, 9086580068258827007,
+	5098242366434867431, 4317569049390253268, 4453199462376638756, 2879402754976920230,
+	767908227601528735, 7024881354969414502, 7233416626069188435, 2468871837473302595,
+	4074999899483421246, 6393224405568578178, 4064595381108100456, 4428099408695898041,
+	5466945615491440398, 6995726942472482846, 9619155974755462276, 9231394009222822857,
+	6254554528094740804, 2964022994369124462, 8878720159129903812, 66783408849213856,
+	7509460174187058582, 6263887604492339068, 3972388343651345866, 7676710775516573326,
+	2266026792528656608, 4035828469144953704, 2827138070404453803, 2027979073689427958,
+	4995220631039238135, 8832341900239014506, 2596137577816823271, 5457427321680012603,
+	8237897375701017940, 2699657163459005769, 2132853298278046539, 7827101575769614436,
+	2175334211316973688, 1397937464605865291, 4409910666641981256, 2629374302120563633,
+	1195236591467737396, 9095041053999131982, 8072647857284932561, 9030515899363315646,
+	9638991305515967267, 9975794999086079592, 7490665178407322158, 3331008369454027415,
+	5569138729890398901, 1320306742775033463, 8891679297718989624, 6552732455833226977,
+	3940677143895329495, 7064960973800799123, 9761608758453933709, 4454705799655308616,
+	6642536993174549674, 244904434452847994, 5338513883976735977, 971823662513335961,
+	9215284700046448466, 6882076503172142117, 1696453761246453644, 9981273543707833961,
+	7753872313963895931, 2354211881806122159, 6560395479536353461, 9346608898674496349,
+	160561603647149684, 8818092301338958901, 5259761553676234736, 9246378529097735626,
+	4500649572425132781, 2955335685261382255, 2604700814043498382, 3280449501743907262,
+	1360749629577361453, 5912155268840181267, 6731807795183670695, 8167115169741104696,
+	2898423756641092913, 1517872774596515798, 8598137302108943666, 3719228991994322450,
+	7602932875378107177, 3401823207809970265, 2248195064645374613, 5968115018083422137,
+	6576396205193090981, 8636472528893136204, 6664626028393502297, 3491819452481644868,
+	6552366242464466292, 8000333224458424725, 1213050347838064098, 5286645543064592188,
+	7973083108526576480, 6379840442531322083, 383339401220316382, 3399319287469611593,
+	5420553295828083230, 5590201716903939058, 8284065707897538017, 2366634581134412997,
+	3441741862895023166, 4546529648183123987, 8862653608863522183, 1772531311202209845,
+	2835560749684843697, 9564164020861987238, 8454883016022843853, 6265725429817596639,
+	777431556831737024, 7113208894804594569, 9700956994914852528, 870669443026582393,
+	904382966264093751, 4974516528438994358, 8602852295641629057, 4165671882288906191,
+	9215260510383164960, 1013787219288104693, 6919600408193224985, 2135185898712762007,
+	2473215006152115180, 9373367820085427590, 8365162245727151516, 8344822979997031590,
+	2760739684129682588, 5540764555259025608, 3904221958317514056, 5616581220606335857,
+	1293061624082413247, 5663462810883450010, 7966557500611154944, 2432458227793684128,
+	9631090909686605456, 9374679708653612376, 2122992261074037206, 6356854768565725174,
+	8536424628614856248, 1591390473706011912, 3144250678798432367, 3689390534019098687,
+	6069801805784665531, 3848329634694380409, 4852116177751176341, 4013781770533652250,
+	5229838055321240917, 2587737867331407065, 3129660608407176905, 7758287248686808702,
+	5968785779758612888, 8750633952978047637, 6053620177285594345, 1448433271757584337,
+	7559207659149559089, 3241145240525947820, 8504820731122539782, 8474651113026395324,
+	214062092666393757, 6360887225257818084, 8519158937885954965, 330728954409441084,
+	3992476608227529388, 9593432053464273514, 5315471714478929469, 144267408674252804,
+	7795912293583367676, 2663833547141176496, 7487286911950024415, 7842592783429824802,
+	4356849136655774953, 8619835972811392494, 5733864478829297238, 1834362934475145162,
+	5274006604250703074, 486543035478522980, 7996880043106707323, 7879259902490729739,
+	1746852433648408780, 8359792764977619500, 4684236737655963155, 7823100738486476166,
+	1237381752112357545, 1229295031446107118, 8457329296787943122, 2550520723537546562,
+	4287014732854505186, 8489704377141604438, 5287305106048046809, 211717158622378432,
+	8197536362763042768, 158185847665600862, 6934407163852749156, 7994537364347612802,
+	3186548412514449427, 9552705614570101633, 4839243259340761248, 5274498891272420338,
+	494760762586528943, 7554898185229490912, 3515932016407579436, 5469497035767249622,
+	6916764952013225379, 9928785263215455242, 1698315738003382131, 2125404753736402949,
+	2242382617749449955, 851705159229694156,
+}
@@ -0,0 +1,96 @@
+package decimal128
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestCos(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var res testDataResult
+
+	for r.scan("cos(%v) = %v\n", &val, &res) {
+		for _, mode := range roundingModes {
+			cos := CosWithMode(val, mode)
+
+			if !res.equal(cos, mode) {
+				t.Errorf("CosWithMode(%v, %v) = %v, want %v", val, mode, cos, res.result(mode))
+			}
+		}
+	}
+}
+
+func TestSin(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var res testDataResult
+
+	for r.scan("sin(%v) = %v\n", &val, &res) {
+		for _, mode := range roundingModes {
+			sin := SinWithMode(val, mode)
+
+			if !res.equal(sin, mode) {
+				t.Errorf("SinWithMode(%v, %v) = %v, want %v", val, mode, sin, res.result(mode))
+			}
+		}
+	}
+}
+
+func TestSincos(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(5))
+	vals := []Decimal{zero(false), zero(true), inf(false), inf(true), NaN()}
+
+	for i := 0; i < 100; i++ {
+		val := randDecimal(r, -30, 6000)
+		if r.Intn(2) == 0 {
+			val = val.Neg()
+		}
+
+		vals = append(vals, val)
+	}
+
+	for _, val := range vals {
+		for _, mode := range roundingModes {
+			sin, cos := SincosWithMode(val, mode)
+
+			if want := SinWithMode(val, mode); !resultEqual(sin, want) {
+				t.Errorf("SincosWithMode(%v, %v) = (%v, _), want (%v, _)", val, mode, sin, want)
+			}
+
+			if want := CosWithMode(val, mode); !resultEqual(cos, want) {
+				t.Errorf("SincosWithMode(%v, %v) = (_, %v), want (_, %v)", val, mode, cos, want)
+			}
+		}
+	}
+}
+
+func TestTan(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var res testDataResult
+
+	for r.scan("tan(%v) = %v\n", &val, &res) {
+		for _, mode := range roundingModes {
+			tan := TanWithMode(val, mode)
+
+			if !res.equal(tan, mode) {
+				t.Errorf("TanWithMode(%v, %v) = %v, want %v", val, mode, tan, res.result(mode))
+			}
+		}
+	}
+}